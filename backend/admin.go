@@ -0,0 +1,41 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"wordle-backend/database"
+	"wordle-backend/models"
+)
+
+const adminUsage = `usage: wordle-backend admin <command> <username>
+
+commands:
+  grant        allow the account to manage word lists through /admin
+  revoke       take administrator rights away from the account`
+
+// runAdmin implements the admin subcommand
+// SECURITY: Administrator rights are only granted from the server's own shell,
+// there is deliberately no HTTP endpoint for it
+func runAdmin(args []string) error {
+	if len(args) != 2 {
+		return errors.New(adminUsage)
+	}
+	
+	var isAdmin bool
+	switch args[0] {
+	case "grant":
+		isAdmin = true
+	case "revoke":
+		isAdmin = false
+	default:
+		return errors.New(adminUsage)
+	}
+	
+	database.InitDB()
+	if err := models.SetUserAdmin(args[1], isAdmin); err != nil {
+		return err
+	}
+	
+	fmt.Printf("%s is now %s\n", args[1], map[bool]string{true: "an administrator", false: "a regular player"}[isAdmin])
+	return nil
+}
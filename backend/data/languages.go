@@ -0,0 +1,112 @@
+// Languages - Alphabets and spelling rules for the word list languages
+//
+// ARCHITECTURE DECISION: Every word is stored and compared in a normalized form
+// - Input is composed (NFC) and uppercased with Unicode case mapping, so "ñ",
+//   "n" + combining tilde and "Ñ" are the same letter
+// - Accents a language does not treat as separate letters are folded away
+//   (Spanish "ÁRBOL" is played as ARBOL), the rest stay distinct letters
+//   (Spanish Ñ, German Ä Ö Ü)
+// - Folding may change the length: German ß is spelled SS, French Œ is OE,
+//   and the word size counts letters after folding
+//
+// TRADE-OFFS CONSIDERED:
+// - Folding vs Keeping every accent: players type on keyboards without dead keys
+//   and the popular clones fold the same way, at the cost of a few homographs
+//   (French PECHE is both péché and pêche)
+package data
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// DefaultLanguage is used when a game does not ask for one; its lists cover every word size
+const DefaultLanguage = "en"
+
+// Language describes the alphabet of one word list language
+type Language struct {
+	Code    string          // ISO 639-1 code, used in the API and as the list directory name
+	Name    string
+	letters string          // Uppercase letters besides A-Z that are letters of their own
+	folds   map[rune]string // Uppercase letters spelled differently before matching
+}
+
+// Languages are the supported word list languages, DefaultLanguage first
+var Languages = []*Language{
+	{Code: "en", Name: "English"},
+	{Code: "es", Name: "Spanish", letters: "Ñ", folds: map[rune]string{
+		'Á': "A", 'É': "E", 'Í': "I", 'Ó': "O", 'Ú': "U", 'Ü': "U",
+	}},
+	{Code: "de", Name: "German", letters: "ÄÖÜ", folds: map[rune]string{
+		'ß': "SS", 'ẞ': "SS",
+	}},
+	{Code: "fr", Name: "French", folds: map[rune]string{
+		'À': "A", 'Â': "A", 'Ä': "A", 'Æ': "AE", 'Ç': "C",
+		'É': "E", 'È': "E", 'Ê': "E", 'Ë': "E", 'Î': "I", 'Ï': "I",
+		'Ô': "O", 'Ö': "O", 'Œ': "OE", 'Ù': "U", 'Û': "U", 'Ü': "U", 'Ÿ': "Y",
+	}},
+}
+
+// LanguageError is returned for a language without word lists
+type LanguageError struct {
+	Language string
+}
+
+func (e *LanguageError) Error() string {
+	return fmt.Sprintf("language must be one of %s, got %q", LanguageCodes(), e.Language)
+}
+
+// GetLanguage looks up a language by code, returning a *LanguageError for unknown codes
+func GetLanguage(code string) (*Language, error) {
+	index := slices.IndexFunc(Languages, func(language *Language) bool { return language.Code == code })
+	if index < 0 {
+		return nil, &LanguageError{Language: code}
+	}
+	return Languages[index], nil
+}
+
+// LanguageCodes lists the language codes for messages, e.g. "en, es, de, fr"
+func LanguageCodes() string {
+	codes := make([]string, len(Languages))
+	for i, language := range Languages {
+		codes[i] = language.Code
+	}
+	return strings.Join(codes, ", ")
+}
+
+// Normalize trims, composes and uppercases a word and folds letters the language
+// does not distinguish; the result is not checked, see NormalizeWord
+func (l *Language) Normalize(word string) string {
+	word = strings.ToUpper(norm.NFC.String(strings.TrimSpace(word)))
+	if len(l.folds) == 0 {
+		return word
+	}
+
+	var folded strings.Builder
+	for _, letter := range word {
+		if replacement, found := l.folds[letter]; found {
+			folded.WriteString(replacement)
+		} else {
+			folded.WriteRune(letter)
+		}
+	}
+	return folded.String()
+}
+
+// NormalizeWord normalizes a word and checks it is wordSize letters of the alphabet
+func (l *Language) NormalizeWord(word string, wordSize int) (string, error) {
+	word = l.Normalize(word)
+	letters := []rune(word)
+	if len(letters) != wordSize || slices.IndexFunc(letters, func(letter rune) bool { return !l.IsLetter(letter) }) >= 0 {
+		return word, fmt.Errorf("%s is not %d %s letters", word, wordSize, l.Name)
+	}
+	return word, nil
+}
+
+// IsLetter reports whether an uppercase rune is a letter of the alphabet
+func (l *Language) IsLetter(letter rune) bool {
+	return (letter >= 'A' && letter <= 'Z') || strings.ContainsRune(l.letters, letter)
+}
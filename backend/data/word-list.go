@@ -1,144 +0,0 @@
-package data
-
-var FourLetterWordList = []string{
-	"ABLE", "ALPS", "ANTS", "ARCH", "AREA", "BAND", "BARK", "BATS", "BEAM", "BEND",
-	"BITE", "BOLD", "BOMB", "BORN", "CANE", "CARS", "CAVE", "COLD", "CUPS", "DAWN",
-	"DEAL", "DICE", "DUST", "EACH", "EASY", "EDGE", "ELSE", "EMIT", "FADE", "FARM",
-	"FIND", "FISH", "FLAT", "FORK", "GALE", "GATE", "GEMS", "GOLD", "GRAY", "HAIL",
-	"HARD", "HAVE", "HIDE", "HILL", "HOLD", "HORN", "HUGS", "IDEA", "IDLE", "IRON",
-	"JACK", "JARS", "JAWY", "JOKE", "JUMP", "KIND", "KITE", "KISS", "LAMB", "LATE",
-	"LEAD", "LEAF", "LIFE", "LINK", "LOOP", "LOVE", "MANY", "MEET", "MIND", "MIST",
-	"MOOD", "MUST", "NAME", "NICE", "NOTE", "OATS", "ONCE", "ONLY", "OPEN", "PART",
-	"PLAN", "PLAY", "POET", "PUSH", "RACE", "RAGE", "RANK", "READ", "RIDE", "RING",
-	"ROAD", "ROCK", "RUSH", "SAFE", "SEAL", "SEEK", "SEED", "SELF", "SHIP", "SLOW",
-	"SNOW", "TASK", "TEAM", "TENT", "TIME", "TIDE", "TOUR", "TURN", "VAST", "WAVE",
-	"WARM", "WIDE", "WIND", "WISH", "YARD", "YARN", "YEAR", "ZONE", "ZOOM", "ZINC",
-	"ZEST", "ZING", "ABLE", "ACID", "AGAR", "AIRS", "AMID", "AWAY", "BITE", "BOLD",
-	"BOOM", "BORN", "BOWL", "CAGE", "COLD", "CUPS", "DART", "DEEP", "EASY", "EDGE",
-	"EPIC", "FAIR", "FAME", "FIRE", "FLUX", "FOAM", "FOLD", "GAZE", "HEAT", "HIDE",
-	"HIVE", "HUGS", "JUMP", "KISS", "KNOT", "LIFT", "MIST", "MOVE", "NEAT", "NOTE",
-	"OPEN", "PACT", "PICK", "POND", "RIDE", "RUNG", "SAFE", "SAND", "SEEK", "SILT",
-	"SLAY", "SLOW", "SOAR", "SWIM", "TAME", "TIDE", "TILT", "VINE", "WAVE", "WIND",
-	"YARD", "YAWN", "YEAR", "ZONE", "BAYE", "BEEP", "BOMB", "CANE", "CORE", "CUTE",
-	"DEEM", "DINE", "ECHO", "GLOW", "HAIL", "HUSH", "ICON", "JAWY", "JEST", "KITE",
-	"LAMP", "LIFT", "MEAL", "MILD", "MOLD", "MUTE", "NEAT", "PICK", "QUAD", "RUSH",
-	"SEAL", "SHEW", "SNAP", "SPIN", "STAY", "TANK", "THIN", "VAST", "WANE", "WISP",
-	"WOMB", "YAWN", "YELL", "YOGA", "YULE", "ZANY", "ZEAL", "ZINC", "ZOOM", "ABLY",
-	"BEND", "BILE", "BLOB", "BLOW", "BOLT", "BORN", "BRIM", "BUMP", "CAMP", "CARD",
-	"CHAT", "CLAM", "CLAY", "COLD", "CURE", "DART", "DEMO", "DINE", "DOVE", "DUST",
-	"EACH", "EASY", "ECHO", "EDGE", "ELAN", "EVEN", "FAIR", "FAST", "FIND", "FIRM",
-	"FLAT", "FLOW", "FOUR", "FUEL", "GAZE", "GLOW", "GOLD", "GRIN", "HAVE", "HIDE",
-	"HILL", "HIVE", "HUSH", "IDEA", "INKY", "JAZZ", "JOLT", "KICK", "KNOT", "LEND",
-	"LICE", "LIFT", "MARS", "MEET", "MILD", "MOSS", "MUTE", "NEED", "NICE", "NODD",
-	"ONCE", "PARK", "PEEK", "PICK", "POND", "QUAD", "RACE", "RAVE", "SAGE", "SEEK",
-	"SIDE", "SLEW", "SLIM", "SNAG", "SPAT", "SPIN", "SQUA", "STAY", "TASK", "TENT",
-	"TIDE", "TILT", "VINE", "WAVE", "WISP", "WISH", "YAWN", "YOGA", "YORE", "YULE",
-	"ZANY", "ZEAL", "ZINC", "ZOOM", "ABLY", "ACID", "AIRS", "AMID", "AWAY", "BAND",
-	"BARK", "BATS", "BEAM", "BITE", "BOLD", "CANE", "COLD", "CUPS", "DART", "DEAL",
-	"DEEP", "DICE", "DUST", "EACH", "EASY", "EDGE", "EELS", "EMIT", "EPIC", "FAME",
-	"FARM", "FIND", "FISH", "FLAT", "FOAM", "GATE", "GEMS", "GOLD", "GRAY", "HAIL",
-	"HARD", "HAVE", "HIDE", "HILL", "HOLD", "HORN", "HUGS", "IDEA", "IDLE", "IRON",
-	"JACK", "JARS", "JAWY", "JOKE", "JUMP", "KIND", "KITE", "KISS", "LAMB", "LATE",
-	"LEAD", "LEAF", "LIFE", "LINK", "LOOP", "LOVE", "MANY", "MEET", "MIND", "MIST",
-	"MOOD", "MUST", "NAME", "NICE", "NOTE", "OATS", "ONCE", "ONLY", "OPEN", "ORAL",
-	"PACK", "PAGE", "PARK", "PART", "PLAN", "PLAY", "POET", "POND", "PUSH", "RACE",
-	"RAGE", "RANK", "READ", "RIDE", "RING", "ROAD", "ROCK", "RUSH", "SAFE", "SEAL",
-	"SEEK", "SEED", "SELF", "SHIP", "SILT", "SING", "SKIP", "SLOW", "SNOW", "TASK",
-	"TEAM", "TENT", "TIME", "TIDE", "TOUR", "TROU", "TURN", "TYPE", "VAST", "WAVE",
-	"WARM", "WIDE", "WIND", "WISH", "WOKE", "YARD", "YARN", "YEAR", "ZONE", "ZOOM",
-	"ZIPS", "ZINC", "ZEST", "ZING", "ZOOM", "ZANY", "ZIPS", "BEEP", "BOMB", "CANE",
-	"COLD", "CUPS", "DART", "DEEP", "DICE", "DUST", "EACH", "EASY", "EDGE", "EELS",
-	"ELSE", "EMIT", "EPIC", "EVER", "EXAM", "FACE", "FAIR", "FAME", "FARM", "FIND",
-	"FISH", "FLAT", "FORK", "FUEL", "GALE", "GATE", "GEMS", "GOLD", "GRAB", "GRAY",
-	"HAIL", "HARD", "HAVE", "HEAD", "HIDE", "HILL", "HOLD", "HORN", "HUGS", "HUNT",
-}
-
-var FiveLetterWordList = []string{
-	"ABOUT", "ABOVE", "ABUSE", "ACTOR", "ACUTE", "ADMIT", "ADOPT", "ADULT", "AFTER", "AGAIN",
-	"AGENT", "AGREE", "AHEAD", "ALARM", "ALBUM", "ALERT", "ALIEN", "ALIGN", "ALIKE", "ALIVE",
-	"ALLOW", "ALONE", "ALONG", "ALTER", "AMONG", "ANGER", "ANGLE", "ANGRY", "APART", "APPLE",
-	"APPLY", "ARENA", "ARGUE", "ARISE", "ARRAY", "ASIDE", "ASSET", "AVOID", "AWAKE", "AWARD",
-	"AWARE", "BADLY", "BAKER", "BASES", "BASIC", "BEACH", "BEGAN", "BEGIN", "BEING", "BELOW",
-	"BENCH", "BILLY", "BIRTH", "BLACK", "BLAME", "BLANK", "BLIND", "BLOCK", "BLOOD", "BOARD",
-	"BOOST", "BOOTH", "BOUND", "BRAIN", "BRAND", "BRASS", "BRAVE", "BREAD", "BREAK", "BREED",
-	"BRIEF", "BRING", "BROAD", "BROKE", "BROWN", "BUILD", "BUILT", "BUYER", "CABLE", "CALIF",
-	"CARRY", "CATCH", "CAUSE", "CHAIN", "CHAIR", "CHAOS", "CHARM", "CHART", "CHASE", "CHEAP",
-	"CHECK", "CHEST", "CHIEF", "CHILD", "CHINA", "CHOSE", "CIVIL", "CLAIM", "CLASS", "CLEAN",
-	"CLEAR", "CLICK", "CLIMB", "CLOCK", "CLOSE", "CLOUD", "COACH", "COAST", "COULD", "COUNT",
-	"COURT", "COVER", "CRAFT", "CRASH", "CRAZY", "CREAM", "CRIME", "CROSS", "CROWD", "CROWN",
-	"CRUDE", "CURVE", "CYCLE", "DAILY", "DANCE", "DATED", "DEALT", "DEATH", "DEBUT", "DELAY",
-	"DEPTH", "DOING", "DOUBT", "DOZEN", "DRAFT", "DRAMA", "DRANK", "DRAWN", "DREAM", "DRESS",
-	"DRILL", "DRINK", "DRIVE", "DROVE", "DYING", "EAGER", "EARLY", "EARTH", "EIGHT", "ELITE",
-	"EMPTY", "ENEMY", "ENJOY", "ENTER", "ENTRY", "EQUAL", "ERROR", "EVENT", "EVERY", "EXACT",
-	"EXIST", "EXTRA", "FAITH", "FALSE", "FAULT", "FIBER", "FIELD", "FIFTH", "FIFTY", "FIGHT",
-	"FINAL", "FIRST", "FIXED", "FLASH", "FLEET", "FLOOR", "FLUID", "FOCUS", "FORCE", "FORTH",
-	"FORTY", "FORUM", "FOUND", "FRAME", "FRANK", "FRAUD", "FRESH", "FRONT", "FROST", "FRUIT",
-	"FULLY", "FUNNY", "GIANT", "GIVEN", "GLASS", "GLOBE", "GOING", "GRACE", "GRADE", "GRAND",
-	"GRANT", "GRASS", "GRAVE", "GREAT", "GREEN", "GROSS", "GROUP", "GROWN", "GUARD", "GUESS",
-	"GUEST", "GUIDE", "HAPPY", "HARRY", "HEART", "HELLO", "HORSE", "HOTEL", "HOUSE", "HUMAN",
-	"IDEAL", "IMAGE", "INDEX", "INNER", "INPUT", "ISSUE", "JAPAN", "JIMMY", "JOINT", "JONES",
-	"JUDGE", "KNOWN", "LABEL", "LARGE", "LASER", "LATER", "LAUGH", "LAYER", "LEARN", "LEASE",
-	"LEAST", "LEAVE", "LEGAL", "LEVEL", "LEWIS", "LIGHT", "LIMIT", "LINKS", "LIVES", "LOCAL",
-	"LOOSE", "LOWER", "LUCKY", "LUNCH", "LYING", "MAGIC", "MAJOR", "MAKER", "MARCH", "MARIA",
-	"MATCH", "MAYBE", "MAYOR", "MEANT", "MEDIA", "METAL", "MIGHT", "MINOR", "MINUS", "MIXED",
-	"MODEL", "MONEY", "MONTH", "MORAL", "MOTOR", "MOUNT", "MOUSE", "MOUTH", "MOVED", "MOVIE",
-	"NEEDS", "NEVER", "NEWLY", "NIGHT", "NOISE", "NORTH", "NOTED", "NOVEL", "NURSE", "OCCUR",
-	"OCEAN", "OFFER", "OFTEN", "ORDER", "OTHER", "OUGHT", "PAINT", "PANEL", "PAPER", "PARTY",
-	"PEACE", "PETER", "PHASE", "PHONE", "PHOTO", "PIANO", "PIECE", "PILOT", "PITCH", "PLACE",
-	"POWER", "PRESS", "PRICE", "PRIDE", "PRIME", "PRINT", "PRIOR", "PRIZE", "PROOF", "PROUD",
-	"PROVE", "QUEEN", "QUICK", "QUIET", "QUITE", "RADIO", "RAISE", "RANGE", "RAPID", "RATIO",
-	"REACH", "READY", "REALM", "REBEL", "REFER", "RELAX", "REPAY", "REPLY", "RIGHT", "RIGID",
-	"RIVER", "ROBIN", "ROGER", "ROMAN", "ROUGH", "ROUND", "ROUTE", "ROYAL", "RURAL", "SCALE",
-	"SCENE", "SCOPE", "SCORE", "SENSE", "SERVE", "SETUP", "SEVEN", "SHALL", "SHAPE", "SHARE",
-	"SHARP", "SHEET", "SHELF", "SHELL", "SHIFT", "SHINE", "SHIRT", "SHOCK", "SHOOT", "SHORT",
-	"SHOWN", "SIDED", "SIGHT", "SILLY", "SINCE", "SIXTY", "SIZED", "SKILL", "SLEEP", "SLIDE",
-	"SORRY", "SOUND", "SOUTH", "SPACE", "SPARE", "SPEAK", "SPEED", "SPEND", "SPENT", "SPLIT",
-	"SPOKE", "SPORT", "STAFF", "STAGE", "STAKE", "STAND", "START", "STATE", "STEAM", "STEEL",
-	"STEEP", "STEER", "STEPS", "STICK", "STILL", "STOCK", "STONE", "STOOD", "STORE", "STORM",
-	"STORY", "STRIP", "STUCK", "STUDY", "STUFF", "STYLE", "SUGAR", "SUITE", "SUPER", "SWEET",
-	"TABLE", "TAKEN", "TASTE", "TAXES", "TEACH", "TEETH", "TERRY", "TEXAS", "THANK", "THEFT",
-	"THEIR", "THEME", "THERE", "THESE", "THICK", "THING", "THINK", "THIRD", "THOSE", "THREE",
-	"THREW", "THROW", "THUMB", "TIGHT", "TIMER", "TIMES", "TITLE", "TODAY", "TOPIC", "TOTAL",
-	"TOUCH", "TOUGH", "TOWER", "TRACK", "TRADE", "TRAIN", "TREAT", "TREND", "TRIAL", "TRIBE",
-	"TRICK", "TRIED", "TRIES", "TRUCK", "TRULY", "TRUNK", "TRUST", "TRUTH", "TWICE", "TWIST",
-	"TYLER", "UNDER", "UNDUE", "UNION", "UNITY", "UNTIL", "UPPER", "UPSET", "URBAN", "USAGE",
-	"USUAL", "VALID", "VALUE", "VIDEO", "VIRUS", "VISIT", "VITAL", "VOCAL", "WASTE", "WATCH",
-	"WHOLE", "WHOSE", "WOMAN", "WOMEN", "WORLD", "WORRY", "WORSE", "WORST", "WORTH", "WOULD",
-	"WRITE", "WRONG", "WROTE", "YOUNG", "YOUTH",
-}
-
-var SixLetterWordList = []string{
-	"ABATED", "ABATES", "ABATED", "ACCORD", "ACCUSE", "ACIDIC", "ACQUIS", "ACQUIT", "ACTIVE", "ACTORS",
-	"ADJOIN", "ADJUST", "ADLINK", "ADULTS", "AFFORD", "AGENCY", "ALARMS", "ALMOST", "ALWAYS", "AMAZED",
-	"AUDITS", "AUSTIN", "AWARED", "AWARDS", "AWAYED", "BAKERY", "BANDIT", "BANGAL", "BARBEC", "BARGED",
-	"BENCHS", "BLAMES", "BLIGHT", "BLINDS", "BLISKS", "BLOOMS", "BLUFFS", "BOILED", "BONDED", "BOTTLE",
-	"BRIDGE", "BUDGET", "BUNNED", "BURDEN", "BUTTON", "CACHED", "CANDLE", "CANDOR", "CAPPED", "CAUGHT",
-	"CLOSES", "CLOWNS", "COATED", "COFFEE", "COMBAT", "COMBOS", "COMICS", "COMMON", "CONFIR", "CONFER",
-	"CONNED", "CONVEX", "COOKIE", "COPIED", "COSTLY", "COTTON", "COURSE", "CREDIT", "CROSSE", "CRUISE",
-	"CULTUR", "CUPPED", "DANCED", "DANGER", "DARTED", "DAUNTY", "DEBTED", "DEVILS", "DIDACT", "DINEIN",
-	"EQUATE", "EQUITY", "EQUINE", "ESCAPE", "ESSAYS", "ETHICS", "ETHELS", "EULERS", "EXCEED", "EXISTS",
-	"FLAIRS", "FLAMES", "FLICKS", "FLOATS", "FLOCKS", "FORCED", "FRAMES", "FRIEND", "FROSTY", "FURNES",
-	"GADGET", "GAMERS", "GAUGES", "GAYEST", "GAYLOR", "GAYNOR", "GAYEST", "GEMINI", "GENIUS", "GIGGLE",
-	"GLOBEY", "GLOVES", "GOLDEN", "GRAILS", "GRANDY", "GRANTS", "GRAVES", "GREATY", "GREETD", "GROOVE",
-	"GROWER", "GUSTED", "HACKED", "HACKER", "HAGGIS", "HALTED", "HANDSY", "HARDLY", "HARMED", "HAULER",
-	"HEADED", "HEAVEN", "HEELED", "HELLOO", "HIDDEN", "HIKING", "HOMELY", "HOPPED", "HUMBLE", "HUMANE",
-	"HURLED", "HURTLE", "HUSTLE", "HYPHEN", "ICICLE", "IDLELY", "IDIOMS", "IGNORE", "IMAGED", "IMPACT",
-	"IMPEDE", "IMPORT", "IMPOSE", "IMPURE", "INCOME", "INDENT", "INDIES", "INDOOR", "INFORM", "INHERI",
-	"JACKED", "JACKER", "JAGGER", "JAGGED", "JAZZED", "JEERED", "JIGSAW", "JIMINY", "JOULES", "JUMBLE",
-	"LACERS", "LACKED", "LADDER", "LAGGED", "LAMPED", "LAUGHY", "LEADED", "LEAGUE", "LEAVES", "LEDGER",
-	"LETTED", "LIFTED", "LITERS", "LITTES", "LOADER", "LOVELY", "LUMBER", "LUSHLY", "LYRICS", "MAGNET",
-	"MALLER", "MANAGE", "MANNER", "MARKET", "MARVEL", "MATURE", "MEANLY", "MEDALS", "MERGER", "MIXERS",
-	"MIXERS", "MIXTUR", "MIXUPS", "MONEYD", "MOUNTY", "MOUTHS", "MUGGED", "MUSEUM", "MUSTED", "MUTEDY",
-	"NARROW", "NATION", "NAVIES", "NEARBY", "NICKED", "NIGHTY", "NIMBLE", "NINETY", "NOBLES", "NOBODY",
-	"OCCURS", "OFFICE", "OFFISH", "OFFERS", "OPTEDY", "OUTBOX", "OUTFIT", "OUTGAS", "OUTLAY", "OUTRUN",
-	"PACKED", "PADDLE", "PASTED", "PAUSED", "PEAKED", "PEANUT", "PEEKED", "PENCIL", "PETTED", "PICKED",
-	"PIERCE", "PLAYED", "POLICY", "POLITE", "PRAISE", "PROFIT", "PULLED", "PUSHED", "QUESTS", "RACERS",
-	"RADARS", "RAGGED", "RANCHO", "RARELY", "RAYING", "REAPED", "RECEIP", "RECORD", "REEDLY", "RELATE",
-	"REMIER", "REPAIR", "REPEAL", "RESORT", "RESUME", "REVIEW", "RIGGED", "RIPPED", "RISKED", "ROCKET",
-	"RUMORS", "RUSSED", "SABERS", "SACRED", "SAFEST", "SAGGER", "SALARY", "SAVEDY", "SCORED", "SEARCH",
-	"SEASON", "SHAPED", "SHOCKD", "SHORTS", "SIMPLE", "SINGED", "SIXTIM", "SKEINS", "SLEEPS", "SLOPES",
-	"SLOTHY", "SMOKED", "SMOKER", "SNORED", "SNORES", "SPEECH", "SPREAD", "SPREAD", "SPREAD", "SPREAD",
-	"STAGED", "STALKS", "STANDY", "STATES", "STICKY", "STINGS", "STOWED", "SUDDEN", "SULFUR", "SWEETS",
-	"TRACKS", "TRAVEL", "TRICKS", "TUNNEL", "TUNERS", "TUNNED", "TURBID", "TWICEY", "UPLIFT", "USAGES",
-	"VAULTS", "VECTOR", "VERBAL", "VIBRAY", "VOTERS", "WACKED", "WAGNER", "WALLET", "WANDER", "WATTED",
-}
\ No newline at end of file
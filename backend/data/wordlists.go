@@ -0,0 +1,449 @@
+// Word Lists - Answer and guess dictionaries loaded at startup
+//
+// ARCHITECTURE DECISION: Plain text files, one word per line
+// - Defaults are embedded from data/lists, so the binary works without any files on disk
+// - One directory per language (en/answers-5.txt, es/guesses-5.txt, ...); see languages.go
+//   for the alphabets. English covers every word size, other languages only some
+// - WORD_LIST_DIR points at a directory of replacement files with the same layout;
+//   files missing there keep the embedded default
+// - WORD_SIZES narrows the playable lengths, e.g. "4-6" or "3,5,7"; every size it names
+//   needs both list files, so adding a length is a matter of shipping its lists
+// - Lists are validated once on startup and indexed in hash sets for constant-time lookups
+// - Runtime changes (see models/wordlist.go) are layered over the files with WithOverrides
+//   and swapped in atomically, so lookups never see a half-built list
+//
+// VALIDATION RULES:
+// - Words are normalized like guesses (see Language.Normalize); blank lines and lines
+//   starting with # are ignored
+// - Wrong lengths, letters outside the alphabet and duplicates are reported and dropped
+// - Answers missing from the guess list of their size are reported and added to it
+// - A language and word size left without answers is a startup error
+package data
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+//go:embed lists
+var defaultListFiles embed.FS
+
+// WordSizes are the word lengths that have answer and guess lists, in ascending order
+// Narrowed by WORD_SIZES in InitWordLists; the embedded English lists cover 3 to 8 letters
+var WordSizes = []int{3, 4, 5, 6, 7, 8}
+
+// WordSizeError is returned for a word length without word lists in a language
+type WordSizeError struct {
+	Language string
+	WordSize int
+}
+
+func (e *WordSizeError) Error() string {
+	if e.Language == DefaultLanguage {
+		return fmt.Sprintf("wordSize must be one of %s, got %d", SupportedWordSizes(e.Language), e.WordSize)
+	}
+	return fmt.Sprintf("wordSize must be one of %s for language %s, got %d", SupportedWordSizes(e.Language), e.Language, e.WordSize)
+}
+
+// CheckWordSize returns a *LanguageError for an unknown language and a *WordSizeError
+// if the language has no lists of wordSize letters
+func CheckWordSize(language string, wordSize int) error {
+	if _, err := GetLanguage(language); err != nil {
+		return err
+	}
+	if !slices.Contains(LanguageWordSizes(language), wordSize) {
+		return &WordSizeError{Language: language, WordSize: wordSize}
+	}
+	return nil
+}
+
+// LanguageWordSizes returns the word sizes a language has lists for, in ascending order
+// Every language but DefaultLanguage may leave sizes out
+func LanguageWordSizes(language string) []int {
+	if defaultLists == nil {
+		return []int{}
+	}
+	return defaultLists.WordSizes(language)
+}
+
+// SupportedWordSizes lists the word sizes of a language for messages, e.g. "3, 4, 5, 6, 7, 8"
+func SupportedWordSizes(language string) string {
+	wordSizes := LanguageWordSizes(language)
+	sizes := make([]string, len(wordSizes))
+	for i, wordSize := range wordSizes {
+		sizes[i] = strconv.Itoa(wordSize)
+	}
+	return strings.Join(sizes, ", ")
+}
+
+// ParseWordSizes parses a WORD_SIZES value: comma-separated lengths and ranges like "3-8"
+// Returns the sizes sorted and without duplicates
+func ParseWordSizes(value string) ([]int, error) {
+	wordSizes := []int{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		low, high, isRange := strings.Cut(part, "-")
+		first, err := strconv.Atoi(strings.TrimSpace(low))
+		if err != nil {
+			return nil, fmt.Errorf("invalid word size %q", part)
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.Atoi(strings.TrimSpace(high)); err != nil || last < first {
+				return nil, fmt.Errorf("invalid word size range %q", part)
+			}
+		}
+		if first < 1 {
+			return nil, fmt.Errorf("word sizes must be positive, got %q", part)
+		}
+		for wordSize := first; wordSize <= last; wordSize++ {
+			wordSizes = append(wordSizes, wordSize)
+		}
+	}
+
+	slices.Sort(wordSizes)
+	return slices.Compact(wordSizes), nil
+}
+
+// WordList is an ordered list of words with a hash-set index for lookups
+type WordList struct {
+	words   []string
+	index   map[string]struct{}
+	version string
+}
+
+func newWordList(words []string) *WordList {
+	index := make(map[string]struct{}, len(words))
+	version := fnv.New64a()
+	for _, word := range words {
+		index[word] = struct{}{}
+		fmt.Fprintln(version, word)
+	}
+	return &WordList{words: words, index: index, version: fmt.Sprintf("%016x", version.Sum64())}
+}
+
+// Version identifies the list's words and their order, e.g. "9f2c4e1a07b3d586"
+// Any runtime change to the list changes it, so a seeded pick can only be
+// reproduced against the same version
+func (l *WordList) Version() string {
+	return l.version
+}
+
+// Contains reports whether an uppercase word is in the list
+func (l *WordList) Contains(word string) bool {
+	_, found := l.index[word]
+	return found
+}
+
+// Len returns the number of words in the list
+func (l *WordList) Len() int {
+	return len(l.words)
+}
+
+// At returns the word at position i in file order
+func (l *WordList) At(i int) string {
+	return l.words[i]
+}
+
+// Words returns a copy of the list in file order
+func (l *WordList) Words() []string {
+	return append([]string{}, l.words...)
+}
+
+// listKey identifies the lists of one language and word size
+type listKey struct {
+	language string
+	wordSize int
+}
+
+// WordLists holds the answer and guess list of every supported language and word size
+type WordLists struct {
+	answers map[listKey]*WordList
+	guesses map[listKey]*WordList
+}
+
+func newWordLists() *WordLists {
+	return &WordLists{
+		answers: make(map[listKey]*WordList),
+		guesses: make(map[listKey]*WordList),
+	}
+}
+
+// Answers returns the target words for a language and word size, nil if there are none
+func (w *WordLists) Answers(language string, wordSize int) *WordList {
+	return w.answers[listKey{language, wordSize}]
+}
+
+// Guesses returns the accepted guesses for a language and word size, nil if there are none
+func (w *WordLists) Guesses(language string, wordSize int) *WordList {
+	return w.guesses[listKey{language, wordSize}]
+}
+
+// WordSizes returns the word sizes the lists cover in a language, in ascending order
+func (w *WordLists) WordSizes(language string) []int {
+	wordSizes := []int{}
+	for key := range w.answers {
+		if key.language == language {
+			wordSizes = append(wordSizes, key.wordSize)
+		}
+	}
+	slices.Sort(wordSizes)
+	return wordSizes
+}
+
+// ListProblem describes one entry that failed validation
+type ListProblem struct {
+	File    string
+	Line    int // 1-based, 0 for problems not tied to a line
+	Word    string
+	Problem string
+}
+
+func (p ListProblem) String() string {
+	if p.Line == 0 {
+		return fmt.Sprintf("%s: %s %s", p.File, p.Word, p.Problem)
+	}
+	return fmt.Sprintf("%s:%d: %s %s", p.File, p.Line, p.Word, p.Problem)
+}
+
+// Word statuses an override can give a word, see WithOverrides
+const (
+	StatusAnswer = "answer" // Answer and guess
+	StatusGuess  = "guess"  // Guess only, e.g. a retired answer
+	StatusBanned = "banned" // Neither answer nor guess
+)
+
+// WordOverride changes the status of one word relative to the list files
+type WordOverride struct {
+	Language string
+	WordSize int
+	Word     string
+	Status   string
+}
+
+// defaultLists are the lists read from files at startup, before any overrides
+var defaultLists *WordLists
+
+// currentLists are the lists in use, replaced atomically when overrides change
+var currentLists atomic.Pointer[WordLists]
+
+// Lists returns the word lists currently in use
+func Lists() *WordLists {
+	return currentLists.Load()
+}
+
+// DefaultLists returns the lists loaded from files, without runtime overrides
+func DefaultLists() *WordLists {
+	return defaultLists
+}
+
+// ReplaceLists swaps in a new set of lists for every subsequent lookup
+func ReplaceLists(lists *WordLists) {
+	currentLists.Store(lists)
+}
+
+// InitWordLists loads the word lists from WORD_LIST_DIR, or the embedded defaults
+// WORD_SIZES, if set, replaces WordSizes before the lists are read
+// Problems are reported on stdout; a list that cannot be used stops the server
+func InitWordLists() {
+	if value := os.Getenv("WORD_SIZES"); value != "" {
+		wordSizes, err := ParseWordSizes(value)
+		if err != nil {
+			fmt.Printf("Error reading WORD_SIZES: %v\n", err)
+			panic("Failed to load word lists") // Critical failure
+		}
+		WordSizes = wordSizes
+	}
+	directory := os.Getenv("WORD_LIST_DIR")
+
+	lists, problems, err := LoadWordLists(directory)
+	for _, problem := range problems {
+		fmt.Printf("Word list problem: %s\n", problem)
+	}
+	if err != nil {
+		fmt.Printf("Error loading word lists: %v\n", err)
+		panic("Failed to load word lists") // Critical failure
+	}
+
+	defaultLists = lists
+	ReplaceLists(lists)
+	for _, language := range Languages {
+		for _, wordSize := range lists.WordSizes(language.Code) {
+			fmt.Printf("Loaded %s %d-letter word lists: %d answers, %d guesses\n", language.Name, wordSize, lists.Answers(language.Code, wordSize).Len(), lists.Guesses(language.Code, wordSize).Len())
+		}
+	}
+}
+
+// LoadWordLists reads and validates the lists for every language and word size
+// Lists live in one directory per language: en/answers-5.txt, es/guesses-5.txt, ...
+// DefaultLanguage needs lists for every size; other languages skip sizes without an answers file
+// directory may be empty to use only the embedded defaults
+func LoadWordLists(directory string) (*WordLists, []ListProblem, error) {
+	lists := newWordLists()
+	problems := []ListProblem{}
+
+	for _, language := range Languages {
+		for _, wordSize := range WordSizes {
+			answersFile := path.Join(language.Code, fmt.Sprintf("answers-%d.txt", wordSize))
+			answers, answerProblems, err := readWordList(directory, answersFile, language, wordSize)
+			if errors.Is(err, fs.ErrNotExist) && language.Code != DefaultLanguage {
+				continue
+			}
+			if err != nil {
+				return nil, problems, err
+			}
+			problems = append(problems, answerProblems...)
+
+			guessesFile := path.Join(language.Code, fmt.Sprintf("guesses-%d.txt", wordSize))
+			guesses, guessProblems, err := readWordList(directory, guessesFile, language, wordSize)
+			if err != nil {
+				return nil, problems, err
+			}
+			problems = append(problems, guessProblems...)
+
+			if len(answers) == 0 {
+				return nil, problems, fmt.Errorf("%s has no valid words", answersFile)
+			}
+
+			// Every answer must be guessable, otherwise a game could be unwinnable
+			guessList := newWordList(guesses)
+			for _, answer := range answers {
+				if !guessList.Contains(answer) {
+					problems = append(problems, ListProblem{File: guessesFile, Word: answer, Problem: "is an answer but not a guess, added"})
+					guesses = append(guesses, answer)
+				}
+			}
+
+			key := listKey{language.Code, wordSize}
+			lists.answers[key] = newWordList(answers)
+			lists.guesses[key] = newWordList(guesses)
+		}
+	}
+
+	return lists, problems, nil
+}
+
+// WithOverrides returns a copy of the lists with each override applied
+// overrides hold at most one entry per language, word size and word, like word_list_overrides
+// Words keep their file order; words added by overrides follow in override order
+// Returns an error if a language and word size would be left without answers
+func (w *WordLists) WithOverrides(overrides []WordOverride) (*WordLists, error) {
+	statuses := make(map[listKey]map[string]string)
+	for _, override := range overrides {
+		key := listKey{override.Language, override.WordSize}
+		if statuses[key] == nil {
+			statuses[key] = make(map[string]string)
+		}
+		statuses[key][override.Word] = override.Status
+	}
+
+	lists := newWordLists()
+	for key, baseAnswers := range w.answers {
+		status := statuses[key]
+		answers := []string{}
+		for _, word := range baseAnswers.words {
+			if override, found := status[word]; !found || override == StatusAnswer {
+				answers = append(answers, word)
+			}
+		}
+		guesses := []string{}
+		for _, word := range w.guesses[key].words {
+			if status[word] != StatusBanned {
+				guesses = append(guesses, word)
+			}
+		}
+
+		for _, override := range overrides {
+			if override.Language != key.language || override.WordSize != key.wordSize {
+				continue
+			}
+			if override.Status == StatusAnswer && !baseAnswers.Contains(override.Word) {
+				answers = append(answers, override.Word)
+			}
+			if override.Status != StatusBanned && !w.guesses[key].Contains(override.Word) {
+				guesses = append(guesses, override.Word)
+			}
+		}
+
+		if len(answers) == 0 {
+			return nil, fmt.Errorf("%s %d-letter words would have no answers left", key.language, key.wordSize)
+		}
+		lists.answers[key] = newWordList(answers)
+		lists.guesses[key] = newWordList(guesses)
+	}
+
+	return lists, nil
+}
+
+// readWordList reads one list file, preferring directory over the embedded default
+// Invalid and duplicate words are left out and reported as problems
+func readWordList(directory string, name string, language *Language, wordSize int) ([]string, []ListProblem, error) {
+	content, source, err := readListFile(directory, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	words := []string{}
+	problems := []ListProblem{}
+	seen := make(map[string]int)
+	for i, line := range strings.Split(string(content), "\n") {
+		if IsBlankListLine(line) {
+			continue
+		}
+
+		word, err := language.NormalizeWord(line, wordSize)
+		problem := ListProblem{File: source, Line: i + 1, Word: word}
+		switch {
+		case err != nil:
+			problem.Problem = fmt.Sprintf("is not %d %s letters, skipped", wordSize, language.Name)
+		case seen[word] != 0:
+			problem.Problem = fmt.Sprintf("duplicates line %d, skipped", seen[word])
+		default:
+			seen[word] = i + 1
+			words = append(words, word)
+			continue
+		}
+		problems = append(problems, problem)
+	}
+
+	return words, problems, nil
+}
+
+// readListFile returns the file from directory if present, else the embedded default
+// source names where the content came from for problem reports
+// The error wraps fs.ErrNotExist when neither has the file
+func readListFile(directory string, name string) ([]byte, string, error) {
+	if directory != "" {
+		filePath := filepath.Join(directory, filepath.FromSlash(name))
+		content, err := os.ReadFile(filePath)
+		if err == nil {
+			return content, filePath, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, filePath, fmt.Errorf("failed to read word list: %v", err)
+		}
+	}
+
+	filePath := "lists/" + name
+	content, err := fs.ReadFile(defaultListFiles, filePath)
+	if err != nil {
+		return nil, filePath, fmt.Errorf("no embedded word list %s: %w", name, err)
+	}
+	return content, "embedded " + filePath, nil
+}
+
+// IsBlankListLine reports whether a list file line holds no word: empty or a # comment
+func IsBlankListLine(line string) bool {
+	line = strings.TrimSpace(line)
+	return line == "" || strings.HasPrefix(line, "#")
+}
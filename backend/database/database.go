@@ -1,18 +1,18 @@
-// Database Layer - SQLite Database Management and Connection Pooling
+// Database Layer - SQLite/PostgreSQL Connection Management and Connection Pooling
 //
-// ARCHITECTURE DECISION: SQLite for development simplicity with connection pooling
+// ARCHITECTURE DECISION: SQLite by default, PostgreSQL selected by configuration
 // - Zero-configuration database setup for easy deployment
 // - Connection pooling for optimal performance
-// - Automatic table creation on startup
+// - Versioned migrations applied on startup (see migrate.go)
 //
 // DESIGN PATTERNS USED:
 // - Singleton Pattern: Single database connection instance
 // - Factory Pattern: Database initialization with proper configuration
 //
 // TRADE-OFFS CONSIDERED:
-// - SQLite vs PostgreSQL: SQLite chosen for simplicity, easily upgradeable
+// - SQLite vs PostgreSQL: SQLite for zero-config development, PostgreSQL via DATABASE_DRIVER
 // - Connection Pooling: Configured for optimal performance vs resource usage
-// - Auto-migration: Simple table creation vs complex migration system
+// - Auto-migration: Pending migrations run on startup, `migrate` subcommand for manual control
 //
 // PRODUCTION CONSIDERATIONS:
 // - Connection limits configured for expected load
@@ -23,16 +23,61 @@ package database
 import (
 	"database/sql"
 	"fmt"
+	"os"
 
+	_ "github.com/lib/pq"
 	_ "modernc.org/sqlite"
 )
 
 var DB *sql.DB
 
+// InitDB connects and brings the schema up to date with every pending migration
 func InitDB() {
+	Connect()
+	
+	migrations, err := MigrateUp(0)
+	if err != nil {
+		fmt.Printf("Error migrating database: %v\n", err)
+		panic("Failed to migrate database") // Critical failure
+	}
+	fmt.Printf("Database schema is up to date (%s, %d migrations applied now)\n", Driver, len(migrations))
+}
+
+// Connect opens the database selected by DATABASE_DRIVER ("sqlite" or "postgres")
+// without touching the schema, see migrate.go
+// DATABASE_URL is the SQLite file path (default api.db) or the PostgreSQL connection URL
+func Connect() {
 	var err error
-	DB, err = sql.Open("sqlite", "api.db")
+	Driver = Dialect(os.Getenv("DATABASE_DRIVER"))
+	if Driver == "" {
+		Driver = SQLite
+	}
+	databaseURL := os.Getenv("DATABASE_URL")
+	
+	switch Driver {
+	case SQLite:
+		if databaseURL == "" {
+			databaseURL = "api.db"
+		}
+		// _time_format=sqlite writes times as "YYYY-MM-DD HH:MM:SS.fff-07:00" so SQLite
+		// date functions (julianday, date) can work on them in leaderboard queries
+		// CONCURRENCY: WAL lets readers proceed during writes, busy_timeout makes writers
+		// wait for the lock instead of failing, and immediate transactions take the write
+		// lock up front so read-then-write transactions cannot deadlock
+		DB, err = sql.Open("sqlite", "file:"+databaseURL+"?_time_format=sqlite&_txlock=immediate&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	case Postgres:
+		if databaseURL == "" {
+			panic("DATABASE_URL is required when DATABASE_DRIVER is postgres")
+		}
+		DB, err = sql.Open("postgres", databaseURL)
+	default:
+		panic(fmt.Sprintf("Unsupported DATABASE_DRIVER %q, use sqlite or postgres", Driver))
+	}
 	
+	if err == nil {
+		// sql.Open is lazy, ping so a bad URL fails at startup rather than on the first request
+		err = DB.Ping()
+	}
 	if err != nil {
 		fmt.Printf("Error connecting to database: %v\n", err)
 		// Critical failure, stop application
@@ -43,39 +88,4 @@ func InitDB() {
 	// MaxIdleConns: Maximum number of connections in the idle connection pool
 	DB.SetMaxOpenConns(10)
 	DB.SetMaxIdleConns(5)
-
-	// Initialize database schema
-	createTables()
-	fmt.Println("Database tables created successfully")
 }
-
-
-
-
-// createTables initializes the database schema
-// DESIGN DECISION: Simple table structure with JSON storage for flexibility
-// TRADE-OFF: JSON storage vs normalized tables - JSON chosen for simplicity
-func createTables() {
-	// Game states table with comprehensive game information
-	// DESIGN: JSON storage for tries array allows flexible game state evolution
-	createGameStatesTable := `
-	CREATE TABLE IF NOT EXISTS game_states (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		target_word TEXT NOT NULL,
-		tries TEXT,
-		game_status TEXT NOT NULL,
-		mode TEXT NOT NULL,
-		max_tries INTEGER NOT NULL,
-		word_size INTEGER NOT NULL DEFAULT 5,
-		created_at DATETIME NOT NULL,
-		updated_at DATETIME NOT NULL
-	)
-	`
-
-	_, err := DB.Exec(createGameStatesTable)
-	if err != nil {
-		fmt.Printf("SQL Error creating game_states table: %v\n", err)
-		panic("Failed to create game_states table") // Critical failure
-	}
-
-}
\ No newline at end of file
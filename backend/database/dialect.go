@@ -0,0 +1,91 @@
+// SQL Dialects - The few places SQLite and PostgreSQL disagree
+//
+// ARCHITECTURE DECISION: Queries are written once in SQLite style and adapted here
+// - "?" placeholders are rewritten to "$1, $2, ..." for PostgreSQL
+// - Date and JSON functions are produced by helpers instead of being hard-coded
+// - Everything else (RETURNING, TRUE/FALSE, partial indexes) is shared syntax
+//
+// TRADE-OFFS CONSIDERED:
+// - Query builder/ORM vs Helpers: A handful of helpers keeps the SQL readable
+//   and avoids a new dependency for what is a small set of differences
+package database
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Dialect identifies the SQL flavour of the configured database
+type Dialect string
+
+const (
+	SQLite   Dialect = "sqlite"
+	Postgres Dialect = "postgres"
+)
+
+// Driver is the dialect of DB, set by InitDB from DATABASE_DRIVER
+var Driver = SQLite
+
+// Rebind converts "?" placeholders to the dialect's placeholder syntax
+// Queries must not contain literal question marks
+func (d Dialect) Rebind(query string) string {
+	if d != Postgres {
+		return query
+	}
+
+	var rebound strings.Builder
+	position := 0
+	for _, char := range query {
+		if char == '?' {
+			position++
+			rebound.WriteString("$" + strconv.Itoa(position))
+			continue
+		}
+		rebound.WriteRune(char)
+	}
+	return rebound.String()
+}
+
+// JSONArrayLength returns an expression counting the elements of a JSON text column
+func (d Dialect) JSONArrayLength(expression string) string {
+	if d == Postgres {
+		return "json_array_length((" + expression + ")::json)"
+	}
+	return "json_array_length(" + expression + ")"
+}
+
+// Timestamp returns a comparable form of a timestamp expression or placeholder
+// SQLite stores times as text with offsets, so they are compared as julian days
+func (d Dialect) Timestamp(expression string) string {
+	if d == Postgres {
+		return expression
+	}
+	return "julianday(" + expression + ")"
+}
+
+// SecondsBetween returns an expression for the seconds elapsed from start to end
+func (d Dialect) SecondsBetween(start string, end string) string {
+	if d == Postgres {
+		return "EXTRACT(EPOCH FROM (" + end + " - " + start + "))"
+	}
+	return "(julianday(" + end + ") - julianday(" + start + ")) * 86400"
+}
+
+// IsUniqueViolation reports whether err was caused by a UNIQUE constraint or index
+func IsUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+	}
+
+	// lib/pq errors expose the SQLSTATE; 23505 is unique_violation
+	var postgresErr interface{ SQLState() string }
+	if errors.As(err, &postgresErr) {
+		return postgresErr.SQLState() == "23505"
+	}
+	return false
+}
@@ -0,0 +1,322 @@
+// Schema Migrations - Embedded, ordered up/down scripts
+//
+// ARCHITECTURE DECISION: Plain SQL files embedded in the binary
+// - migrations/<dialect>/NNNN_name.up.sql and NNNN_name.down.sql, numbered alike for every dialect
+// - schema_migrations records which versions are applied and when
+// - Each migration runs in one transaction together with its schema_migrations row,
+//   so a failed script leaves neither a half-altered schema nor a wrong version
+// - InitDB applies pending migrations on startup; `migrate` on the binary gives manual control
+//
+// TRADE-OFFS CONSIDERED:
+// - Library (golang-migrate, goose) vs Own runner: No new dependency, and the runner can
+//   adopt databases created before migrations existed
+// - Forward-only vs Up/Down: Down scripts let a bad deploy be rolled back
+package database
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed migrations
+var migrationFiles embed.FS
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus reports whether a migration has been applied
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// legacyProbes detect migrations that createTables applied before schema_migrations existed
+// Each query only succeeds if the columns that migration adds are present
+var legacyProbes = map[int]string{
+	1: `SELECT id FROM game_states LIMIT 0`,
+	2: `SELECT time_limit, guess_deadline FROM game_states LIMIT 0`,
+	3: `SELECT user_id FROM game_states LIMIT 0`,
+	4: `SELECT daily_date FROM game_states LIMIT 0`,
+	5: `SELECT hard_mode FROM game_states LIMIT 0`,
+	6: `SELECT version FROM game_states LIMIT 0`,
+}
+
+// loadMigrations reads the embedded scripts for the configured dialect, oldest first
+func loadMigrations() ([]Migration, error) {
+	directory := "migrations/" + string(Driver)
+	entries, err := fs.ReadDir(migrationFiles, directory)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for %s: %v", Driver, err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected file in %s: %s", directory, entry.Name())
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		script, err := fs.ReadFile(migrationFiles, directory+"/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration := byVersion[version]
+		if migration == nil {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(script)
+		} else {
+			migration.Down = string(script)
+		}
+	}
+
+	migrations := []Migration{}
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// ensureMigrationsTable creates schema_migrations and adopts pre-migration databases
+func ensureMigrationsTable() error {
+	timestampType := "DATETIME"
+	if Driver == Postgres {
+		timestampType = "TIMESTAMPTZ"
+	}
+
+	_, err := DB.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at ` + timestampType + ` NOT NULL
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %v", err)
+	}
+
+	return adoptLegacySchema()
+}
+
+// adoptLegacySchema records the migrations an unversioned database already has
+// BUSINESS RULE: Only a gap-free prefix is adopted; later migrations then run normally
+func adoptLegacySchema() error {
+	var count int
+	if err := DB.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&count); err != nil {
+		return fmt.Errorf("failed to read schema_migrations: %v", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		probe, ok := legacyProbes[migration.Version]
+		if !ok {
+			return nil
+		}
+		rows, err := DB.Query(probe)
+		if err != nil {
+			return nil
+		}
+		rows.Close()
+
+		if err := recordMigration(DB, migration); err != nil {
+			return err
+		}
+		fmt.Printf("Adopted existing schema as migration %04d_%s\n", migration.Version, migration.Name)
+	}
+
+	return nil
+}
+
+// GetMigrationStatus lists every known migration and whether it is applied
+func GetMigrationStatus() ([]MigrationStatus, error) {
+	if err := ensureMigrationsTable(); err != nil {
+		return nil, err
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []MigrationStatus{}
+	for _, migration := range migrations {
+		appliedAt, ok := applied[migration.Version]
+		statuses = append(statuses, MigrationStatus{Migration: migration, Applied: ok, AppliedAt: appliedAt})
+	}
+
+	return statuses, nil
+}
+
+// MigrateUp applies up to steps pending migrations in version order, all of them if steps is 0
+func MigrateUp(steps int) ([]Migration, error) {
+	statuses, err := GetMigrationStatus()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnknownMigrations(statuses); err != nil {
+		return nil, err
+	}
+
+	done := []Migration{}
+	for _, status := range statuses {
+		if status.Applied {
+			continue
+		}
+		if steps > 0 && len(done) == steps {
+			break
+		}
+		if err := runMigration(status.Migration, true); err != nil {
+			return done, err
+		}
+		done = append(done, status.Migration)
+	}
+
+	return done, nil
+}
+
+// MigrateDown rolls back the steps most recently applied migrations
+func MigrateDown(steps int) ([]Migration, error) {
+	statuses, err := GetMigrationStatus()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUnknownMigrations(statuses); err != nil {
+		return nil, err
+	}
+
+	done := []Migration{}
+	for i := len(statuses) - 1; i >= 0 && len(done) < steps; i-- {
+		if !statuses[i].Applied {
+			continue
+		}
+		if err := runMigration(statuses[i].Migration, false); err != nil {
+			return done, err
+		}
+		done = append(done, statuses[i].Migration)
+	}
+
+	return done, nil
+}
+
+// checkUnknownMigrations refuses to touch a database migrated by a newer build
+func checkUnknownMigrations(statuses []MigrationStatus) error {
+	applied, err := appliedMigrations()
+	if err != nil {
+		return err
+	}
+
+	known := make(map[int]bool)
+	for _, status := range statuses {
+		known[status.Version] = true
+	}
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("database has migration %d which this build does not know, upgrade the backend first", version)
+		}
+	}
+
+	return nil
+}
+
+func appliedMigrations() (map[int]time.Time, error) {
+	rows, err := DB.Query(`SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %v", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations: %v", err)
+		}
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+// runMigration executes one script and updates schema_migrations in the same transaction
+func runMigration(migration Migration, up bool) error {
+	direction, script := "down", migration.Down
+	if up {
+		direction, script = "up", migration.Up
+	}
+
+	tx, err := DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %04d_%s: %v", migration.Version, migration.Name, err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(script); err != nil {
+		return fmt.Errorf("migration %04d_%s %s failed: %v", migration.Version, migration.Name, direction, err)
+	}
+
+	if up {
+		err = recordMigration(tx, migration)
+	} else {
+		_, err = tx.Exec(Driver.Rebind(`DELETE FROM schema_migrations WHERE version = ?`), migration.Version)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update schema_migrations for %04d_%s: %v", migration.Version, migration.Name, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %04d_%s: %v", migration.Version, migration.Name, err)
+	}
+
+	fmt.Printf("Migrated %s: %04d_%s\n", direction, migration.Version, migration.Name)
+	return nil
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+func recordMigration(db execer, migration Migration) error {
+	_, err := db.Exec(Driver.Rebind(`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`), migration.Version, migration.Name, time.Now())
+	return err
+}
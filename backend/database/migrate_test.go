@@ -0,0 +1,47 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// TestMigrateDownAndUp rolls every migration back and reapplies it on a fresh SQLite file,
+// so each down script must undo its up script completely
+func TestMigrateDownAndUp(t *testing.T) {
+	t.Setenv("DATABASE_DRIVER", "sqlite")
+	t.Setenv("DATABASE_URL", filepath.Join(t.TempDir(), "test.db"))
+	Connect()
+	t.Cleanup(func() { DB.Close() })
+
+	applied, err := MigrateUp(0)
+	if err != nil {
+		t.Fatalf("MigrateUp: %v", err)
+	}
+	migrations, err := loadMigrations()
+	if err != nil {
+		t.Fatalf("loadMigrations: %v", err)
+	}
+	if len(applied) != len(migrations) {
+		t.Fatalf("applied %d migrations, want %d", len(applied), len(migrations))
+	}
+
+	rolledBack, err := MigrateDown(len(migrations))
+	if err != nil {
+		t.Fatalf("MigrateDown: %v", err)
+	}
+	if len(rolledBack) != len(migrations) {
+		t.Fatalf("rolled back %d migrations, want %d", len(rolledBack), len(migrations))
+	}
+
+	var tables int
+	if err := DB.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name NOT IN ('schema_migrations', 'sqlite_sequence')`).Scan(&tables); err != nil {
+		t.Fatalf("counting tables: %v", err)
+	}
+	if tables != 0 {
+		t.Fatalf("%d tables left after migrating down", tables)
+	}
+
+	if reapplied, err := MigrateUp(0); err != nil || len(reapplied) != len(migrations) {
+		t.Fatalf("MigrateUp again: applied %d, error %v", len(reapplied), err)
+	}
+}
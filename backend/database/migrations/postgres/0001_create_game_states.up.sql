@@ -0,0 +1,13 @@
+-- Game states table with comprehensive game information
+-- DESIGN: JSON storage for tries array allows flexible game state evolution
+CREATE TABLE IF NOT EXISTS game_states (
+	id BIGSERIAL PRIMARY KEY,
+	target_word TEXT NOT NULL,
+	tries TEXT,
+	game_status TEXT NOT NULL,
+	mode TEXT NOT NULL,
+	max_tries INTEGER NOT NULL,
+	word_size INTEGER NOT NULL DEFAULT 5,
+	created_at TIMESTAMPTZ NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,2 @@
+ALTER TABLE game_states DROP COLUMN guess_deadline;
+ALTER TABLE game_states DROP COLUMN time_limit;
@@ -0,0 +1,3 @@
+-- Speed mode: seconds per guess and the server-side cutoff for the next guess
+ALTER TABLE game_states ADD COLUMN time_limit INTEGER NOT NULL DEFAULT 45;
+ALTER TABLE game_states ADD COLUMN guess_deadline TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+ALTER TABLE game_states DROP COLUMN user_id;
+DROP TABLE sessions;
+DROP TABLE users;
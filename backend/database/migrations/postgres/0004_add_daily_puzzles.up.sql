@@ -0,0 +1,8 @@
+-- Puzzle date (YYYY-MM-DD) of daily games
+ALTER TABLE game_states ADD COLUMN daily_date TEXT;
+
+-- One daily game per player, date and word size
+-- DESIGN: Partial unique index backs up the store check against concurrent creates
+CREATE UNIQUE INDEX IF NOT EXISTS idx_game_states_daily_user
+ON game_states (user_id, daily_date, word_size)
+WHERE mode = 'daily';
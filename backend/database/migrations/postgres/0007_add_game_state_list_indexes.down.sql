@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_game_states_created_at;
+DROP INDEX IF EXISTS idx_game_states_filters;
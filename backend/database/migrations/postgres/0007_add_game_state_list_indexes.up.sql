@@ -0,0 +1,3 @@
+-- Indexes for GET /gamestates filters and created_at ranges
+CREATE INDEX IF NOT EXISTS idx_game_states_filters ON game_states (mode, game_status, word_size);
+CREATE INDEX IF NOT EXISTS idx_game_states_created_at ON game_states (created_at);
@@ -0,0 +1,37 @@
+-- One row per submitted guess, for SQL analytics on guess behaviour
+-- game_states.tries stays the source of truth for playing; this table is written alongside it
+-- results has one character per letter: C = correct, P = incorrect-position, A = incorrect
+-- submitted_at and latency_ms are NULL for backfilled guesses, since tries JSON has no timestamps
+CREATE TABLE guesses (
+	game_id BIGINT NOT NULL REFERENCES game_states(id),
+	guess_index INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	results TEXT NOT NULL,
+	is_correct BOOLEAN NOT NULL,
+	submitted_at TIMESTAMPTZ,
+	latency_ms BIGINT,
+	PRIMARY KEY (game_id, guess_index)
+);
+
+CREATE INDEX idx_guesses_word ON guesses (word);
+
+-- Backfill from existing tries; games whose tries are not a JSON array are skipped
+INSERT INTO guesses (game_id, guess_index, word, results, is_correct)
+SELECT
+	g.id,
+	try.ordinality - 1,
+	try.value->>'guessWord',
+	COALESCE((
+		SELECT string_agg(
+			CASE letter.value->>'status'
+				WHEN 'correct' THEN 'C'
+				WHEN 'incorrect-position' THEN 'P'
+				ELSE 'A'
+			END, '' ORDER BY letter.ordinality)
+		FROM json_array_elements(try.value->'letterResultArray') WITH ORDINALITY AS letter(value, ordinality)
+	), ''),
+	COALESCE((try.value->>'isCorrect')::boolean, FALSE)
+FROM game_states g
+CROSS JOIN LATERAL json_array_elements(
+	CASE WHEN json_typeof(g.tries::json) = 'array' THEN g.tries::json ELSE '[]'::json END
+) WITH ORDINALITY AS try(value, ordinality);
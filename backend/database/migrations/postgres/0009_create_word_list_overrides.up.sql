@@ -0,0 +1,24 @@
+-- Administrators may manage word lists through /admin endpoints
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Runtime changes layered over the word list files, one row per word and size
+-- status is "answer" (answer and guess), "guess" (guess only, e.g. retired) or "banned"
+CREATE TABLE word_list_overrides (
+	word_size INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	status TEXT NOT NULL,
+	updated_by BIGINT NOT NULL REFERENCES users(id),
+	updated_at TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (word_size, word)
+);
+
+-- Audit trail of every word list change and who made it
+CREATE TABLE word_list_changes (
+	id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL REFERENCES users(id),
+	word_size INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	action TEXT NOT NULL,
+	previous_status TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,13 @@
+-- Non-English overrides have no place in the old key and are dropped
+ALTER TABLE word_list_changes DROP COLUMN language;
+
+DELETE FROM word_list_overrides WHERE language <> 'en';
+ALTER TABLE word_list_overrides DROP CONSTRAINT word_list_overrides_pkey;
+ALTER TABLE word_list_overrides ADD PRIMARY KEY (word_size, word);
+ALTER TABLE word_list_overrides DROP COLUMN language;
+
+DROP INDEX IF EXISTS idx_game_states_daily_user;
+ALTER TABLE game_states DROP COLUMN language;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_game_states_daily_user
+ON game_states (user_id, daily_date, word_size)
+WHERE mode = 'daily';
@@ -0,0 +1,15 @@
+-- Word list language of each game; existing games were all English
+ALTER TABLE game_states ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
+
+-- One daily game per player, date, language and word size
+DROP INDEX IF EXISTS idx_game_states_daily_user;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_game_states_daily_user
+ON game_states (user_id, daily_date, language, word_size)
+WHERE mode = 'daily';
+
+-- Overrides are per language
+ALTER TABLE word_list_overrides ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
+ALTER TABLE word_list_overrides DROP CONSTRAINT word_list_overrides_pkey;
+ALTER TABLE word_list_overrides ADD PRIMARY KEY (language, word_size, word);
+
+ALTER TABLE word_list_changes ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
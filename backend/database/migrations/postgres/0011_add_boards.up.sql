@@ -0,0 +1,3 @@
+-- Targets, tries and statuses of each board of a multi-board game, as JSON
+-- NULL for single-board games, which keep using target_word and tries
+ALTER TABLE game_states ADD COLUMN boards TEXT;
@@ -0,0 +1,3 @@
+ALTER TABLE game_states DROP COLUMN list_version;
+ALTER TABLE game_states DROP COLUMN seeded;
+ALTER TABLE game_states DROP COLUMN seed;
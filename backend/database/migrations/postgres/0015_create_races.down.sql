@@ -0,0 +1,3 @@
+DROP TABLE race_guesses;
+DROP TABLE race_players;
+DROP TABLE races;
@@ -0,0 +1,41 @@
+-- Multiplayer races: 2 to 8 players guess the same hidden word, first to solve it wins
+-- SECURITY: target_word never leaves the server until the race is finished
+-- status can be: "waiting" (lobby), "racing" or "finished"; winner_id is NULL for a race nobody solved
+CREATE TABLE races (
+	id BIGSERIAL PRIMARY KEY,
+	code TEXT NOT NULL UNIQUE,
+	host_id BIGINT NOT NULL REFERENCES users(id),
+	target_word TEXT NOT NULL,
+	language TEXT NOT NULL,
+	word_size INTEGER NOT NULL,
+	max_tries INTEGER NOT NULL,
+	max_players INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	winner_id BIGINT REFERENCES users(id),
+	created_at TIMESTAMPTZ NOT NULL,
+	started_at TIMESTAMPTZ,
+	finished_at TIMESTAMPTZ
+);
+
+-- status can be: "playing", "won" or "lost"
+CREATE TABLE race_players (
+	race_id BIGINT NOT NULL REFERENCES races(id),
+	user_id BIGINT NOT NULL REFERENCES users(id),
+	status TEXT NOT NULL,
+	joined_at TIMESTAMPTZ NOT NULL,
+	finished_at TIMESTAMPTZ,
+	PRIMARY KEY (race_id, user_id)
+);
+
+-- One row per guess, kept so a finished race can be replayed
+-- results has one character per letter, like guesses.results: C = correct, P = incorrect-position, A = incorrect
+CREATE TABLE race_guesses (
+	race_id BIGINT NOT NULL REFERENCES races(id),
+	user_id BIGINT NOT NULL REFERENCES users(id),
+	guess_index INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	results TEXT NOT NULL,
+	is_correct BOOLEAN NOT NULL,
+	submitted_at TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (race_id, user_id, guess_index)
+);
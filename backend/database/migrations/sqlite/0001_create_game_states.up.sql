@@ -0,0 +1,14 @@
+-- Game states table with comprehensive game information
+-- DESIGN: JSON storage for tries array allows flexible game state evolution
+-- IF NOT EXISTS so databases created before migrations keep their rows
+CREATE TABLE IF NOT EXISTS game_states (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	target_word TEXT NOT NULL,
+	tries TEXT,
+	game_status TEXT NOT NULL,
+	mode TEXT NOT NULL,
+	max_tries INTEGER NOT NULL,
+	word_size INTEGER NOT NULL DEFAULT 5,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL
+);
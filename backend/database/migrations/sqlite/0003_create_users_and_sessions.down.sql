@@ -0,0 +1,24 @@
+-- SQLite cannot drop a column with a foreign key, so game_states is rebuilt without user_id
+CREATE TABLE game_states_rollback (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	target_word TEXT NOT NULL,
+	tries TEXT,
+	game_status TEXT NOT NULL,
+	mode TEXT NOT NULL,
+	max_tries INTEGER NOT NULL,
+	word_size INTEGER NOT NULL DEFAULT 5,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	time_limit INTEGER NOT NULL DEFAULT 45,
+	guess_deadline DATETIME
+);
+
+INSERT INTO game_states_rollback (id, target_word, tries, game_status, mode, max_tries, word_size, created_at, updated_at, time_limit, guess_deadline)
+SELECT id, target_word, tries, game_status, mode, max_tries, word_size, created_at, updated_at, time_limit, guess_deadline
+FROM game_states;
+
+DROP TABLE game_states;
+ALTER TABLE game_states_rollback RENAME TO game_states;
+
+DROP TABLE sessions;
+DROP TABLE users;
@@ -0,0 +1,21 @@
+-- Player accounts; passwords are stored as bcrypt hashes only
+-- Guests are accounts without a password until their games are claimed
+CREATE TABLE users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	username TEXT NOT NULL UNIQUE,
+	password_hash TEXT NOT NULL,
+	is_guest BOOLEAN NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL
+);
+
+-- Login sessions keyed by SHA-256 of the bearer token
+-- SECURITY: A leaked database does not leak usable tokens
+CREATE TABLE sessions (
+	token_hash TEXT PRIMARY KEY,
+	user_id INTEGER NOT NULL REFERENCES users(id),
+	created_at DATETIME NOT NULL,
+	expires_at DATETIME NOT NULL
+);
+
+-- Owning player of each game, NULL for games created before accounts existed
+ALTER TABLE game_states ADD COLUMN user_id INTEGER REFERENCES users(id);
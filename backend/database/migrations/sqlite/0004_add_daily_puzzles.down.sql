@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_game_states_daily_user;
+ALTER TABLE game_states DROP COLUMN daily_date;
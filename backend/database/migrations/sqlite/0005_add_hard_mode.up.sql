@@ -0,0 +1 @@
+ALTER TABLE game_states ADD COLUMN hard_mode BOOLEAN NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE game_states DROP COLUMN version;
@@ -0,0 +1,2 @@
+-- Incremented on every write for optimistic locking
+ALTER TABLE game_states ADD COLUMN version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Indexes for GET /gamestates filters and created_at ranges
+-- DESIGN: Expression index matches the julianday() comparisons used by range filters
+CREATE INDEX IF NOT EXISTS idx_game_states_filters ON game_states (mode, game_status, word_size);
+CREATE INDEX IF NOT EXISTS idx_game_states_created_at ON game_states (julianday(created_at));
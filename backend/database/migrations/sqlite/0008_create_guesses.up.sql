@@ -0,0 +1,34 @@
+-- One row per submitted guess, for SQL analytics on guess behaviour
+-- game_states.tries stays the source of truth for playing; this table is written alongside it
+-- results has one character per letter: C = correct, P = incorrect-position, A = incorrect
+-- submitted_at and latency_ms are NULL for backfilled guesses, since tries JSON has no timestamps
+CREATE TABLE guesses (
+	game_id INTEGER NOT NULL REFERENCES game_states(id),
+	guess_index INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	results TEXT NOT NULL,
+	is_correct BOOLEAN NOT NULL,
+	submitted_at DATETIME,
+	latency_ms INTEGER,
+	PRIMARY KEY (game_id, guess_index)
+);
+
+CREATE INDEX idx_guesses_word ON guesses (word);
+
+-- Backfill from existing tries; games whose tries are not a JSON array are skipped
+INSERT INTO guesses (game_id, guess_index, word, results, is_correct)
+SELECT
+	g.id,
+	try.key,
+	json_extract(try.value, '$.guessWord'),
+	COALESCE((
+		SELECT group_concat(
+			CASE json_extract(letter.value, '$.status')
+				WHEN 'correct' THEN 'C'
+				WHEN 'incorrect-position' THEN 'P'
+				ELSE 'A'
+			END, '' ORDER BY letter.key)
+		FROM json_each(try.value, '$.letterResultArray') AS letter
+	), ''),
+	COALESCE(json_extract(try.value, '$.isCorrect'), 0)
+FROM game_states g, json_each(CASE WHEN json_type(g.tries) = 'array' THEN g.tries ELSE '[]' END) AS try;
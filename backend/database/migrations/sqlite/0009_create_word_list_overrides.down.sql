@@ -0,0 +1,3 @@
+DROP TABLE word_list_changes;
+DROP TABLE word_list_overrides;
+ALTER TABLE users DROP COLUMN is_admin;
@@ -0,0 +1,21 @@
+-- Non-English overrides have no place in the old table and are dropped
+ALTER TABLE word_list_changes DROP COLUMN language;
+
+CREATE TABLE word_list_overrides_old (
+	word_size INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	status TEXT NOT NULL,
+	updated_by INTEGER NOT NULL REFERENCES users(id),
+	updated_at DATETIME NOT NULL,
+	PRIMARY KEY (word_size, word)
+);
+INSERT INTO word_list_overrides_old (word_size, word, status, updated_by, updated_at)
+SELECT word_size, word, status, updated_by, updated_at FROM word_list_overrides WHERE language = 'en';
+DROP TABLE word_list_overrides;
+ALTER TABLE word_list_overrides_old RENAME TO word_list_overrides;
+
+DROP INDEX IF EXISTS idx_game_states_daily_user;
+ALTER TABLE game_states DROP COLUMN language;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_game_states_daily_user
+ON game_states (user_id, daily_date, word_size)
+WHERE mode = 'daily';
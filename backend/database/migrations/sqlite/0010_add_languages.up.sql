@@ -0,0 +1,25 @@
+-- Word list language of each game; existing games were all English
+ALTER TABLE game_states ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
+
+-- One daily game per player, date, language and word size
+DROP INDEX IF EXISTS idx_game_states_daily_user;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_game_states_daily_user
+ON game_states (user_id, daily_date, language, word_size)
+WHERE mode = 'daily';
+
+-- Overrides are per language; SQLite cannot change a primary key in place
+CREATE TABLE word_list_overrides_new (
+	language TEXT NOT NULL DEFAULT 'en',
+	word_size INTEGER NOT NULL,
+	word TEXT NOT NULL,
+	status TEXT NOT NULL,
+	updated_by INTEGER NOT NULL REFERENCES users(id),
+	updated_at DATETIME NOT NULL,
+	PRIMARY KEY (language, word_size, word)
+);
+INSERT INTO word_list_overrides_new (word_size, word, status, updated_by, updated_at)
+SELECT word_size, word, status, updated_by, updated_at FROM word_list_overrides;
+DROP TABLE word_list_overrides;
+ALTER TABLE word_list_overrides_new RENAME TO word_list_overrides;
+
+ALTER TABLE word_list_changes ADD COLUMN language TEXT NOT NULL DEFAULT 'en';
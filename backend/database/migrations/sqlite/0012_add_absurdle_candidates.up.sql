@@ -0,0 +1,3 @@
+-- Words an absurdle game may still pick, as JSON; NULL for every other mode
+-- target_word stays empty until a single candidate is left
+ALTER TABLE game_states ADD COLUMN candidates TEXT;
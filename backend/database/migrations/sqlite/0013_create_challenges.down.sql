@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_game_states_challenge_user;
+ALTER TABLE game_states DROP COLUMN challenge_id;
+DROP TABLE challenges;
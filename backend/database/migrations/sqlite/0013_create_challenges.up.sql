@@ -0,0 +1,22 @@
+-- Words picked by one player for others to guess, shared by a random code
+-- SECURITY: target_word never leaves the server; the code is the only way in
+CREATE TABLE challenges (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	code TEXT NOT NULL UNIQUE,
+	creator_id INTEGER NOT NULL REFERENCES users(id),
+	target_word TEXT NOT NULL,
+	language TEXT NOT NULL,
+	word_size INTEGER NOT NULL,
+	max_tries INTEGER NOT NULL,
+	hard_mode BOOLEAN NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL
+);
+
+-- Challenge a game was started from, NULL for every other mode
+-- No REFERENCES: SQLite cannot drop a column with a foreign key, see 0003 down
+ALTER TABLE game_states ADD COLUMN challenge_id INTEGER;
+
+-- One game per player and challenge; also serves the creator's results listing
+CREATE UNIQUE INDEX IF NOT EXISTS idx_game_states_challenge_user
+ON game_states (challenge_id, user_id)
+WHERE challenge_id IS NOT NULL;
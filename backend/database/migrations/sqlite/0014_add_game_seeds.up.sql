@@ -0,0 +1,5 @@
+-- Seed and answer list version a speed game's words were picked with, NULL for other modes
+-- seeded marks seeds the player chose; those games are left out of leaderboards
+ALTER TABLE game_states ADD COLUMN seed INTEGER;
+ALTER TABLE game_states ADD COLUMN seeded BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE game_states ADD COLUMN list_version TEXT;
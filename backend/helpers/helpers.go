@@ -1,54 +1,128 @@
 package helpers
 
 import (
-	"database/sql"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
-	"slices"
-	"strings"
+	"os"
+	"unicode/utf8"
 	"wordle-backend/data"
-	"wordle-backend/database"
 )
 
-func GetNextID(tableName string) (int64, error) {
-	var maxID sql.NullInt64
-	query := fmt.Sprintf("SELECT MAX(id) FROM %s", tableName)
-	err := database.DB.QueryRow(query).Scan(&maxID)
+// defaultDailySeed is mixed into the daily word hash when DAILY_SEED is not set
+const defaultDailySeed = "wordle-daily"
+
+// MaxSeed is the largest game seed, 2^53 - 1, so seeds survive JSON numbers in JavaScript
+const MaxSeed = 1<<53 - 1
+
+// RNG is the source of randomness for picking words
+// DESIGN DECISION: Passed in instead of using the global math/rand source, so a game
+// seeded the same way picks the same words again; *rand.Rand satisfies it
+type RNG interface {
+	Intn(n int) int
+	Perm(n int) []int
+}
+
+// NewRNG returns the deterministic RNG for a game seed
+// math/rand keeps seeded sequences stable across Go releases, so old seeds stay valid
+func NewRNG(seed int64) RNG {
+	return rand.New(rand.NewSource(seed))
+}
+
+// NewSeed draws a seed for a game created without one
+func NewSeed() int64 {
+	return rand.Int63n(MaxSeed + 1)
+}
+
+// getAnswerList returns the curated target words for a language and word size
+// Returns a *data.LanguageError or *data.WordSizeError rather than guessing another list
+func getAnswerList(language string, wordSize int) (*data.WordList, error) {
+	if err := data.CheckWordSize(language, wordSize); err != nil {
+		return nil, err
+	}
+	return data.Lists().Answers(language, wordSize), nil
+}
+
+// GetRandomWord picks a target word from the answer list
+func GetRandomWord(rng RNG, language string, wordSize int) (string, error) {
+	wordList, err := getAnswerList(language, wordSize)
+	if err != nil {
+		return "", err
+	}
+	return wordList.At(rng.Intn(wordList.Len())), nil
+}
+
+// GetRandomWords picks count different target words from the answer list, e.g. for multi-board games
+func GetRandomWords(rng RNG, language string, wordSize int, count int) ([]string, error) {
+	wordList, err := getAnswerList(language, wordSize)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get max ID from %s: %v", tableName, err)
+		return nil, err
+	}
+	if count > wordList.Len() {
+		return nil, fmt.Errorf("only %d answers for %d-letter words, cannot pick %d", wordList.Len(), wordSize, count)
 	}
 	
-	if maxID.Valid {
-		return maxID.Int64 + 1, nil
+	words := make([]string, count)
+	for i, index := range rng.Perm(wordList.Len())[:count] {
+		words[i] = wordList.At(index)
 	}
-	return 1, nil
+	return words, nil
 }
 
-func GetRandomWord(wordSize int) string {
-	switch wordSize {
-	case 4:
-		return data.FourLetterWordList[rand.Intn(len(data.FourLetterWordList))]
-	case 5:
-		return data.FiveLetterWordList[rand.Intn(len(data.FiveLetterWordList))]
-	case 6:
-		return data.SixLetterWordList[rand.Intn(len(data.SixLetterWordList))]
-	default:
-		return data.FiveLetterWordList[rand.Intn(len(data.FiveLetterWordList))]
+// GetAnswerListVersion identifies the answer list seeded picks are made from, see data.WordList.Version
+func GetAnswerListVersion(language string, wordSize int) (string, error) {
+	wordList, err := getAnswerList(language, wordSize)
+	if err != nil {
+		return "", err
+	}
+	return wordList.Version(), nil
+}
+
+// GetAnswerWords returns every target word of a language and word size, e.g. the
+// starting candidates of an absurdle game
+func GetAnswerWords(language string, wordSize int) ([]string, error) {
+	wordList, err := getAnswerList(language, wordSize)
+	if err != nil {
+		return nil, err
 	}
+	return wordList.Words(), nil
 }
 
-func IsWordInList(word string) bool {
-	word = strings.ToUpper(strings.TrimSpace(word))
-	wordLength := len(word)
+// GetDailyWord deterministically picks the word of the day for a date and word size
+// DESIGN DECISION: Hash of seed + date + size instead of math/rand so every server
+// instance agrees on the word without storing it; changing DAILY_SEED reshuffles all days
+// The language is only mixed in for languages other than English, which keeps the
+// English daily words from before languages were added
+// BUSINESS RULE: Picked from the default answer lists, never the runtime overrides;
+// an admin adding or retiring a word must not change a day's word halfway through it
+func GetDailyWord(language string, wordSize int, date string) (string, error) {
+	seed := os.Getenv("DAILY_SEED")
+	if seed == "" {
+		seed = defaultDailySeed
+	}
+	
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%s:%s:%d", seed, date, wordSize)
+	if language != data.DefaultLanguage {
+		fmt.Fprintf(hash, ":%s", language)
+	}
 	
-	switch wordLength {
-	case 4:
-		return slices.Contains(data.FourLetterWordList, word)
-	case 5:
-		return slices.Contains(data.FiveLetterWordList, word)
-	case 6:
-		return slices.Contains(data.SixLetterWordList, word)
-	default:
+	if err := data.CheckWordSize(language, wordSize); err != nil {
+		return "", err
+	}
+	wordList := data.DefaultLists().Answers(language, wordSize)
+	return wordList.At(int(hash.Sum64() % uint64(wordList.Len()))), nil
+}
+
+// IsWordInList reports whether a word is an allowed guess in a language
+// BUSINESS RULE: Checked against the guess list, which is broader than the answer list
+// PERFORMANCE: Hash-set lookup instead of scanning the list on every guess
+func IsWordInList(language string, word string) bool {
+	wordLanguage, err := data.GetLanguage(language)
+	if err != nil {
 		return false
 	}
+	word = wordLanguage.Normalize(word)
+	guesses := data.Lists().Guesses(language, utf8.RuneCountInString(word))
+	return guesses != nil && guesses.Contains(word)
 }
\ No newline at end of file
@@ -2,7 +2,7 @@
 //
 // ARCHITECTURE DECISION: Microservice approach with clear separation of concerns
 // - RESTful API design following OpenAPI standards
-// - SQLite for simplicity in development (easily upgradeable to PostgreSQL/MySQL)
+// - SQLite for simplicity in development, PostgreSQL selected with DATABASE_DRIVER=postgres
 // - Gin framework for high-performance HTTP routing
 // - CORS enabled for frontend integration
 //
@@ -21,7 +21,10 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
+	"wordle-backend/data"
 	"wordle-backend/database"
+	"wordle-backend/models"
 	"wordle-backend/routes"
 
 	"github.com/gin-contrib/cors"
@@ -31,6 +34,23 @@ import (
 // main initializes the Wordle backend server with proper configuration
 // and middleware setup for production-ready deployment
 func main() {
+	// `wordle-backend migrate ...` manages the schema instead of starting the server
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+	// `wordle-backend admin ...` grants or revokes administrator rights
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		if err := runAdmin(os.Args[2:]); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+	
 	// Environment-based configuration for flexible deployment
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -42,7 +62,13 @@ func main() {
 	}
 	
 	fmt.Println("Starting server...")
+	data.InitWordLists()
 	database.InitDB()
+	models.InitGameStore()
+	if err := models.LoadWordListOverrides(); err != nil {
+		fmt.Printf("Error loading word list overrides: %v\n", err)
+		panic("Failed to load word list overrides") // Critical failure
+	}
 	fmt.Println("Database initialized successfully")
 	
 	router := gin.Default()
@@ -54,6 +80,7 @@ func main() {
 	config.AllowCredentials = false
 	router.Use(cors.New(config))
 	
+	routes.RaceOrigins = []string{strings.TrimSuffix(apiUrl, "/")}
 	routes.RegisterRoutes(router)
 	
 	router.GET("/", func(c *gin.Context) {
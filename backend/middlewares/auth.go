@@ -0,0 +1,89 @@
+// Authentication Middleware - Resolves bearer tokens to player accounts
+//
+// ARCHITECTURE DECISION: Optional authentication at the router level
+// - Authenticate runs on every request and only attaches a user when a token is sent
+// - RequireAuth is added per route where an account is mandatory
+// - Anonymous play keeps working for clients that never log in
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// userIDKey is the gin context key holding the authenticated user ID
+const userIDKey = "userId"
+
+// Authenticate attaches the user behind an "Authorization: Bearer <token>" header
+// An invalid token is rejected outright rather than silently treated as anonymous
+func Authenticate() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		header := context.GetHeader("Authorization")
+		if header == "" {
+			context.Next()
+			return
+		}
+		
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			context.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header must be a Bearer token"})
+			return
+		}
+		
+		userID, err := models.GetUserIDBySessionToken(token)
+		if errors.Is(err, models.ErrInvalidSession) {
+			context.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if err != nil {
+			context.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to authenticate: " + err.Error()})
+			return
+		}
+		
+		context.Set(userIDKey, userID)
+		context.Next()
+	}
+}
+
+// RequireAuth rejects requests that did not present a valid token
+func RequireAuth() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		if GetUserID(context) == 0 {
+			context.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+		context.Next()
+	}
+}
+
+// RequireAdmin rejects requests from accounts without administrator rights
+// Must run after RequireAuth
+func RequireAdmin() gin.HandlerFunc {
+	return func(context *gin.Context) {
+		user, err := models.GetUserByID(GetUserID(context))
+		if err != nil {
+			context.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to load user: " + err.Error()})
+			return
+		}
+		if !user.IsAdmin {
+			context.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Administrator rights required"})
+			return
+		}
+		context.Next()
+	}
+}
+
+// GetUserID returns the authenticated user ID, or 0 for anonymous requests
+func GetUserID(context *gin.Context) int64 {
+	return context.GetInt64(userIDKey)
+}
+
+// BearerToken returns the raw token sent with the request, if any
+func BearerToken(context *gin.Context) string {
+	token, _ := strings.CutPrefix(context.GetHeader("Authorization"), "Bearer ")
+	return token
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"wordle-backend/database"
+)
+
+const migrateUsage = `usage: wordle-backend migrate <command>
+
+commands:
+  status       list migrations and whether they are applied
+  up [N]       apply the next N pending migrations (default: all)
+  down [N]     roll back the N most recent migrations (default: 1)`
+
+// runMigrate implements the migrate subcommand
+// DESIGN: Connects without the automatic migration InitDB does, so "down" and
+// "status" see the schema exactly as it is
+func runMigrate(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New(migrateUsage)
+	}
+	
+	steps := 0
+	if len(args) == 2 {
+		var err error
+		steps, err = strconv.Atoi(args[1])
+		if err != nil || steps < 1 {
+			return fmt.Errorf("steps must be a positive number, got %q", args[1])
+		}
+	}
+	
+	database.Connect()
+	
+	switch args[0] {
+	case "status":
+		statuses, err := database.GetMigrationStatus()
+		if err != nil {
+			return err
+		}
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.Applied {
+				appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Fprintf(writer, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return writer.Flush()
+	case "up":
+		migrations, err := database.MigrateUp(steps)
+		if err == nil && len(migrations) == 0 {
+			fmt.Println("No pending migrations")
+		}
+		return err
+	case "down":
+		if steps == 0 {
+			steps = 1
+		}
+		migrations, err := database.MigrateDown(steps)
+		if err == nil && len(migrations) == 0 {
+			fmt.Println("No applied migrations to roll back")
+		}
+		return err
+	default:
+		return errors.New(migrateUsage)
+	}
+}
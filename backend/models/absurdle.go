@@ -0,0 +1,110 @@
+// Absurdle Mode - The server avoids committing to a word for as long as it can
+//
+// ARCHITECTURE DECISION: Candidate set instead of a target word
+// - An absurdle game starts with every answer of its language and word size as candidates
+// - Each guess keeps the largest group of candidates that agree on its feedback and
+//   drops the rest, so the feedback is always true for every word still possible
+// - TargetWord stays empty until a single candidate remains, or the game is lost
+// - Candidates are stored as JSON in game_states.candidates, like tries
+//
+// ALGORITHM: Largest bucket wins
+// - The candidates are grouped by the pattern ValidateGuess would give the guess
+//   if that candidate were the target
+// - The largest group is kept; ties go to the group revealing least: fewest
+//   correct letters, then fewest incorrect-position letters, then pattern order
+// - The guess only wins once it is the last candidate, since then its own group
+//   (all correct) is the only one left
+//
+// TRADE-OFFS CONSIDERED:
+// - Word list vs Indices: words are stored so a runtime word list change cannot
+//   shift the candidates of running games
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNoCandidates is returned when an absurdle game has no candidate words left to dodge with,
+// which only a corrupted or hand-edited game can reach
+var ErrNoCandidates = errors.New("absurdle game has no candidate words left")
+
+// candidateBucket is the candidates that give a guess the same feedback
+type candidateBucket struct {
+	pattern       string
+	letterResults []LetterResult
+	candidates    []string
+}
+
+// revealed counts the correct and incorrect-position letters of the bucket's pattern
+func (b candidateBucket) revealed() (int, int) {
+	return strings.Count(b.pattern, "C"), strings.Count(b.pattern, "P")
+}
+
+// dodgesBetter reports whether bucket b should be kept over other
+func (b candidateBucket) dodgesBetter(other candidateBucket) bool {
+	if len(b.candidates) != len(other.candidates) {
+		return len(b.candidates) > len(other.candidates)
+	}
+	correct, present := b.revealed()
+	otherCorrect, otherPresent := other.revealed()
+	if correct != otherCorrect {
+		return correct < otherCorrect
+	}
+	if present != otherPresent {
+		return present < otherPresent
+	}
+	return b.pattern < other.pattern
+}
+
+// partitionCandidates groups candidates by the feedback a guess would get from each
+func partitionCandidates(candidates []string, guessWord string) []candidateBucket {
+	buckets := []candidateBucket{}
+	bucketIndex := make(map[string]int)
+	for _, candidate := range candidates {
+		guessResult := GuessResult{LetterResultArray: ValidateGuess(guessWord, candidate)}
+		pattern := guessResult.Pattern()
+		if i, found := bucketIndex[pattern]; found {
+			buckets[i].candidates = append(buckets[i].candidates, candidate)
+			continue
+		}
+		bucketIndex[pattern] = len(buckets)
+		buckets = append(buckets, candidateBucket{pattern: pattern, letterResults: guessResult.LetterResultArray, candidates: []string{candidate}})
+	}
+	return buckets
+}
+
+// dodgeGuess scores a normalized guess on an absurdle game and narrows its candidates
+// to the largest bucket, committing to the target word once one candidate remains
+// Returns ErrNoCandidates, leaving gs untouched, if there is nothing to narrow
+func (gs *GameState) dodgeGuess(guessWord string) (GuessResult, error) {
+	if len(gs.Candidates) == 0 {
+		return GuessResult{}, ErrNoCandidates
+	}
+	buckets := partitionCandidates(gs.Candidates, guessWord)
+	kept := buckets[0]
+	for _, bucket := range buckets[1:] {
+		if bucket.dodgesBetter(kept) {
+			kept = bucket
+		}
+	}
+
+	gs.Candidates = kept.candidates
+	if len(gs.Candidates) == 1 {
+		gs.TargetWord = gs.Candidates[0]
+	}
+	return GuessResult{
+		GuessWord:         guessWord,
+		LetterResultArray: kept.letterResults,
+		IsCorrect:         len(gs.Candidates) == 1 && gs.Candidates[0] == guessWord,
+	}, nil
+}
+
+// commitCandidate fixes the target word of an absurdle game that ended undecided,
+// so it can be revealed like any other game's
+func (gs *GameState) commitCandidate() {
+	if gs.TargetWord == "" && len(gs.Candidates) > 0 {
+		gs.TargetWord = gs.Candidates[0]
+		gs.Candidates = gs.Candidates[:1]
+	}
+}
@@ -0,0 +1,101 @@
+// Multi-Board Games - One guess played on 2, 4 or 8 boards at once (Dordle, Quordle, Octordle)
+//
+// ARCHITECTURE DECISION: Boards ride along on the regular game state
+// - Single-board games leave Boards empty and play exactly as before
+// - Each board holds its own target word, the tries scored against it and its status
+// - GameState.Tries still gets one entry per guess so stats and leaderboards count
+//   guesses unchanged; on multi-board games its letter results are empty and
+//   IsCorrect means the guess solved a board
+// - Boards are stored as JSON in game_states.boards, like tries; their guesses are
+//   not copied into the guesses table, which has one pattern per guess
+//
+// BUSINESS RULES:
+// - A guess is scored against every unsolved board; solved boards take no more tries
+// - The game is won once every board is solved, and lost when tries run out first
+// - One extra try per extra board, so 6 tries on one board become 7, 9 or 13
+// - Daily puzzles have a single board
+package models
+
+import (
+	"fmt"
+	"slices"
+)
+
+// BoardCounts are the allowed number of boards per game; 1 is a regular game
+var BoardCounts = []int{1, 2, 4, 8}
+
+// Board is one target word of a multi-board game
+// Status can be: "playing", "won" (solved) or "lost" (unsolved when the game was lost)
+type Board struct {
+	TargetWord string        `json:"targetWord"` // Hidden from client until solved, see routes.BoardResponse
+	Tries      []GuessResult `json:"tries"`
+	Status     string        `json:"status"`
+}
+
+// CheckBoardCount rejects board counts other than BoardCounts
+func CheckBoardCount(boards int) error {
+	if !slices.Contains(BoardCounts, boards) {
+		return fmt.Errorf("boards must be 1, 2, 4 or 8, got %d", boards)
+	}
+	return nil
+}
+
+// newBoards creates one playing board per target word
+func newBoards(targetWords []string) []Board {
+	boards := make([]Board, len(targetWords))
+	for i, targetWord := range targetWords {
+		boards[i] = Board{TargetWord: targetWord, Tries: []GuessResult{}, Status: StatusPlaying}
+	}
+	return boards
+}
+
+// IsMultiBoard reports whether the game is played on more than one board
+func (gs *GameState) IsMultiBoard() bool {
+	return len(gs.Boards) > 0
+}
+
+// IsTargetWord reports whether a normalized word is the target of the game or of one of its boards
+func (gs *GameState) IsTargetWord(word string) bool {
+	if !gs.IsMultiBoard() {
+		return word == gs.TargetWord
+	}
+	return slices.ContainsFunc(gs.Boards, func(board Board) bool { return board.TargetWord == word })
+}
+
+// AllBoardsSolved reports whether every board of a multi-board game is solved
+func (gs *GameState) AllBoardsSolved() bool {
+	return !slices.ContainsFunc(gs.Boards, func(board Board) bool { return board.Status != StatusWon })
+}
+
+// scoreBoards scores a normalized guess on every unsolved board
+// Returns the game-level try: the guess word, and whether it solved a board
+func (gs *GameState) scoreBoards(guessWord string) GuessResult {
+	guessResult := GuessResult{GuessWord: guessWord, LetterResultArray: []LetterResult{}}
+	for i := range gs.Boards {
+		board := &gs.Boards[i]
+		if board.Status != StatusPlaying {
+			continue
+		}
+
+		boardResult := GuessResult{
+			GuessWord:         guessWord,
+			LetterResultArray: ValidateGuess(guessWord, board.TargetWord),
+			IsCorrect:         guessWord == board.TargetWord,
+		}
+		board.Tries = append(board.Tries, boardResult)
+		if boardResult.IsCorrect {
+			board.Status = StatusWon
+			guessResult.IsCorrect = true
+		}
+	}
+	return guessResult
+}
+
+// loseBoards marks the boards left unsolved when a multi-board game is lost
+func (gs *GameState) loseBoards() {
+	for i := range gs.Boards {
+		if gs.Boards[i].Status == StatusPlaying {
+			gs.Boards[i].Status = StatusLost
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Challenge Models - Games on a word picked by another player
+//
+// ARCHITECTURE DECISION: A challenges row holds the word; games started from it
+// are regular game_states rows with mode "challenge" and challenge_id set
+// - Challenges are shared by a random code (96 bits), so they cannot be enumerated
+// - The word never leaves the server: challenge responses omit it, and challenge
+//   games do not reveal their target word even once finished
+//
+// BUSINESS RULES:
+// - The word must be an allowed guess of its language; its length sets the word size
+// - Every player gets one game per challenge; creators cannot play their own
+// - Only the creator may list the results
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+	"wordle-backend/data"
+	"wordle-backend/database"
+	"wordle-backend/helpers"
+)
+
+// Challenge is a word picked by one player for others to guess
+type Challenge struct {
+	ID         int64     `json:"-"`
+	Code       string    `json:"code"`
+	CreatorID  int64     `json:"creatorId"`
+	TargetWord string    `json:"-"` // Never sent to clients
+	Language   string    `json:"language"`
+	WordSize   int       `json:"wordSize"`
+	MaxTries   int       `json:"maxTries"`
+	HardMode   bool      `json:"hardMode"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// ChallengeResult is the outcome of one player's game on a challenge
+type ChallengeResult struct {
+	GameID     int64     `json:"gameId"`
+	UserID     int64     `json:"userId"`
+	Username   string    `json:"username"`
+	GameStatus string    `json:"gameStatus"`
+	Guesses    int       `json:"guesses"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+var (
+	ErrChallengeNotFound       = errors.New("challenge not found")
+	ErrChallengeRequiresPlayer = errors.New("challenge games require a player")
+	ErrChallengeAlreadyPlayed  = errors.New("challenge already played")
+	ErrOwnChallenge            = errors.New("cannot play your own challenge")
+	ErrNotChallengeCreator     = errors.New("only the challenge creator can list its results")
+)
+
+// ChallengeOptions collects what a player chooses when creating a challenge
+type ChallengeOptions struct {
+	Word      string
+	Language  string // Defaults to data.DefaultLanguage
+	MaxTries  int
+	HardMode  bool
+	CreatorID int64
+}
+
+// CreateChallenge validates a challenge word and settings without saving anything
+// Game Rule: The word is spelled the way the language's word lists are, see data.Language
+func CreateChallenge(options ChallengeOptions) (Challenge, error) {
+	if options.Language == "" {
+		options.Language = data.DefaultLanguage
+	}
+	language, err := data.GetLanguage(options.Language)
+	if err != nil {
+		return Challenge{}, err
+	}
+
+	word := language.Normalize(options.Word)
+	wordSize := utf8.RuneCountInString(word)
+	if err := data.CheckWordSize(options.Language, wordSize); err != nil {
+		return Challenge{}, err
+	}
+	if !helpers.IsWordInList(options.Language, word) {
+		return Challenge{}, fmt.Errorf("%s is not a valid word", word)
+	}
+	minTries, maxTries := MaxTriesRange(wordSize)
+	if options.MaxTries < minTries || options.MaxTries > maxTries {
+		return Challenge{}, fmt.Errorf("maxTries must be between %d and %d for %d-letter words, got %d", minTries, maxTries, wordSize, options.MaxTries)
+	}
+
+	code, err := randomHex(12)
+	if err != nil {
+		return Challenge{}, fmt.Errorf("failed to generate challenge code: %v", err)
+	}
+
+	return Challenge{
+		Code:       code,
+		CreatorID:  options.CreatorID,
+		TargetWord: word,
+		Language:   options.Language,
+		WordSize:   wordSize,
+		MaxTries:   options.MaxTries,
+		HardMode:   options.HardMode,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// SaveChallenge inserts a new challenge and sets challenge.ID
+func SaveChallenge(challenge *Challenge) error {
+	query := `
+		INSERT INTO challenges (code, creator_id, target_word, language, word_size, max_tries, hard_mode, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`
+
+	err := database.DB.QueryRow(database.Driver.Rebind(query), challenge.Code, challenge.CreatorID, challenge.TargetWord, challenge.Language, challenge.WordSize, challenge.MaxTries, challenge.HardMode, challenge.CreatedAt).Scan(&challenge.ID)
+	if err != nil {
+		return fmt.Errorf("failed to save challenge: %v", err)
+	}
+
+	return nil
+}
+
+// GetChallengeByCode loads a challenge, returning ErrChallengeNotFound for unknown codes
+func GetChallengeByCode(code string) (Challenge, error) {
+	var challenge Challenge
+	query := `
+		SELECT id, code, creator_id, target_word, language, word_size, max_tries, hard_mode, created_at
+		FROM challenges
+		WHERE code = ?
+	`
+
+	err := database.DB.QueryRow(database.Driver.Rebind(query), code).Scan(&challenge.ID, &challenge.Code, &challenge.CreatorID, &challenge.TargetWord,
+		&challenge.Language, &challenge.WordSize, &challenge.MaxTries, &challenge.HardMode, &challenge.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Challenge{}, ErrChallengeNotFound
+	}
+	if err != nil {
+		return Challenge{}, fmt.Errorf("failed to load challenge: %v", err)
+	}
+
+	return challenge, nil
+}
+
+// GetChallengeResults lists every game played on a challenge, oldest first
+// SECURITY: Only the creator may see who played and how it went
+func GetChallengeResults(challenge Challenge, userID int64) ([]ChallengeResult, error) {
+	results := []ChallengeResult{}
+	if userID != challenge.CreatorID {
+		return results, ErrNotChallengeCreator
+	}
+	if err := TimeoutOverdueGames(time.Now()); err != nil {
+		return results, err
+	}
+
+	query := `
+		SELECT g.id, u.id, u.username, g.game_status, ` + database.Driver.JSONArrayLength("COALESCE(g.tries, '[]')") + `, g.created_at, g.updated_at
+		FROM game_states g
+		JOIN users u ON u.id = g.user_id
+		WHERE g.challenge_id = ?
+		ORDER BY g.created_at, g.id
+	`
+
+	rows, err := database.DB.Query(database.Driver.Rebind(query), challenge.ID)
+	if err != nil {
+		return results, fmt.Errorf("failed to get challenge results: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result ChallengeResult
+		if err := rows.Scan(&result.GameID, &result.UserID, &result.Username, &result.GameStatus, &result.Guesses, &result.CreatedAt, &result.UpdatedAt); err != nil {
+			return results, fmt.Errorf("failed to scan challenge result: %v", err)
+		}
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
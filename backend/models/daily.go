@@ -0,0 +1,91 @@
+// Daily Puzzle Models - Shared word of the day
+//
+// ARCHITECTURE DECISION: Daily games are regular game_states rows with mode "daily"
+// - The target word is derived from the date, so no puzzle table is needed
+// - daily_date records which puzzle a game belongs to for per-day queries
+//
+// BUSINESS RULES:
+// - Dates are calendar days in UTC so every player sees the same puzzle
+// - Each language and word size is its own puzzle; a player gets one game of each per day
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"wordle-backend/database"
+)
+
+// DailyDateLayout is the canonical YYYY-MM-DD form used in URLs and storage
+const DailyDateLayout = "2006-01-02"
+
+// DailyEpoch is the date of daily puzzle #1
+var DailyEpoch = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ErrDailyRequiresPlayer is returned when an anonymous client starts a daily game
+var ErrDailyRequiresPlayer = errors.New("daily games require a signed-in player")
+
+// ErrDailyAlreadyPlayed is returned when a player starts a second daily game for the same puzzle
+var ErrDailyAlreadyPlayed = errors.New("daily puzzle already played for this date, language and word size")
+
+// DailyResult aggregates outcomes of one daily puzzle language and word size
+type DailyResult struct {
+	Language string `json:"language"`
+	WordSize int    `json:"wordSize"`
+	Played   int    `json:"played"`
+	Won      int    `json:"won"`
+}
+
+// FormatDailyDate converts an instant to the UTC puzzle date it belongs to
+func FormatDailyDate(t time.Time) string {
+	return t.UTC().Format(DailyDateLayout)
+}
+
+// ParseDailyDate validates a puzzle date, rejecting dates before the epoch or in the future
+func ParseDailyDate(value string) (time.Time, error) {
+	date, err := time.Parse(DailyDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("date must be formatted as YYYY-MM-DD, got %q", value)
+	}
+	if date.Before(DailyEpoch) {
+		return time.Time{}, fmt.Errorf("no daily puzzle before %s", DailyEpoch.Format(DailyDateLayout))
+	}
+	if date.After(time.Now().UTC()) {
+		return time.Time{}, fmt.Errorf("daily puzzle for %s is not available yet", value)
+	}
+	return date, nil
+}
+
+// DailyPuzzleNumber returns the 1-based puzzle number for a date
+func DailyPuzzleNumber(date time.Time) int {
+	return int(date.Sub(DailyEpoch).Hours()/24) + 1
+}
+
+// GetDailyResults summarises how a daily puzzle went for each language and word size
+func GetDailyResults(dailyDate string) ([]DailyResult, error) {
+	results := []DailyResult{}
+	
+	query := `
+		SELECT language, word_size, COUNT(*), COALESCE(SUM(CASE WHEN game_status = 'won' THEN 1 ELSE 0 END), 0)
+		FROM game_states
+		WHERE mode = 'daily' AND daily_date = ?
+		GROUP BY language, word_size
+		ORDER BY language, word_size
+	`
+	
+	rows, err := database.DB.Query(database.Driver.Rebind(query), dailyDate)
+	if err != nil {
+		return results, fmt.Errorf("failed to get daily results: %v", err)
+	}
+	defer rows.Close()
+	
+	for rows.Next() {
+		var result DailyResult
+		if err := rows.Scan(&result.Language, &result.WordSize, &result.Played, &result.Won); err != nil {
+			return results, fmt.Errorf("failed to scan daily result: %v", err)
+		}
+		results = append(results, result)
+	}
+	
+	return results, rows.Err()
+}
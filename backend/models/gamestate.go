@@ -6,7 +6,7 @@
 // - Validation logic centralized in model methods
 //
 // DESIGN PATTERNS USED:
-// - Repository Pattern: Database operations encapsulated in model methods
+// - Repository Pattern: Persistence delegated to the configured GameStore (see store.go)
 // - Factory Pattern: CreateGameState function for object creation
 // - Strategy Pattern: Different game modes (speed vs classic) handled polymorphically
 //
@@ -22,11 +22,11 @@
 package models
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
-	"wordle-backend/database"
+	"wordle-backend/data"
 	"wordle-backend/helpers"
 )
 
@@ -45,178 +45,366 @@ type GuessResult struct {
 	IsCorrect bool           `json:"isCorrect"`
 }
 
+// letterStatusCodes are the one-character codes used by GuessResult.Pattern
+var letterStatusCodes = map[string]string{
+	"correct":            "C",
+	"incorrect-position": "P",
+	"incorrect":          "A",
+}
+
+// Pattern encodes the letter results one character per letter, e.g. "CAPAC"
+// C = correct, P = incorrect-position, A = incorrect; stored in guesses.results
+func (g GuessResult) Pattern() string {
+	var pattern strings.Builder
+	for _, letterResult := range g.LetterResultArray {
+		pattern.WriteString(letterStatusCodes[letterResult.Status])
+	}
+	return pattern.String()
+}
+
+// GuessDeadlineGrace absorbs network latency between the client timer hitting zero
+// and the guess reaching the server, so honest players are not cut off early
+const GuessDeadlineGrace = 2 * time.Second
+
 // GameState represents the complete state of a Wordle game session
 // DESIGN DECISION: Immutable target word for security
-// GameStatus can be: "playing", "won", "lost", "timeout"
+// GameStatus can be: "playing", "won", "lost", "timeout", "abandoned" (see status.go)
+// Mode can be: "speed" (random word, per-guess timer), "daily" (shared word of the day)
+// "absurdle" (no word until the guesses leave one, see absurdle.go)
+// or "challenge" (word picked by another player, see challenge.go)
 type GameState struct {
 	ID          int64     `json:"id"`
-	TargetWord  string    `json:"targetWord"`  // Hidden from client until game ends
+	TargetWord  string    `json:"-"`  // Hidden from client until game ends, see routes.GameStateResponse
 	Tries []GuessResult `json:"tries"`
+	Boards      []Board   `json:"boards"`        // Per-board targets and tries, empty for single-board games (see boards.go)
+	Candidates  []string  `json:"-"`             // Words an absurdle game may still pick, server-side only
 	GameStatus string    `json:"gameStatus"`
 	Mode string    `json:"mode"`
 	MaxTries    int       `json:"maxTries"`
 	WordSize    int       `json:"wordSize"`
+	Language    string    `json:"language"`      // Word list language code, e.g. "en" (see data.Languages)
+	TimeLimit   int       `json:"timeLimit"`     // Seconds allowed per guess in speed mode
+	GuessDeadline time.Time `json:"guessDeadline"` // Server-side cutoff for the next guess
+	UserID      int64     `json:"userId"`        // Owning player account, 0 for anonymous games
+	DailyDate   string    `json:"dailyDate"`     // Puzzle date (YYYY-MM-DD) for daily games
+	ChallengeID int64     `json:"challengeId"`   // Challenge a challenge game was started from
+	Seed        int64     `json:"seed"`          // Seed the speed game's words were picked with
+	Seeded      bool      `json:"seeded"`        // Seed was chosen by the player, so the words were knowable
+	ListVersion string    `json:"listVersion"`   // Answer list version the speed game's words were picked from
+	HardMode    bool      `json:"hardMode"`      // Revealed hints must be reused in later guesses
+	Version     int       `json:"version"`       // Incremented on every write for optimistic locking
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
 
+// ErrStaleGameState is returned when a game changed between being read and written
+var ErrStaleGameState = errors.New("game state was modified by another request, reload and retry")
+
+
+// GameOptions collects the settings a player chooses when starting a game
+// DESIGN DECISION: Options struct keeps the factory signature stable as modes are added
+type GameOptions struct {
+	Mode      string
+	MaxTries  int
+	WordSize  int
+	Language  string // Defaults to data.DefaultLanguage
+	Boards    int    // Target words played at once, 1 (default), 2, 4 or 8
+	TimeLimit int
+	UserID    int64
+	HardMode  bool
+	Challenge *Challenge // Required for challenge games, whose settings it replaces
+	Seed      *int64     // Optional for speed games; drawn at random when nil
+}
+
+// MaxTriesRange returns the fewest and most tries a game of wordSize letters may allow
+// BUSINESS RULE: 5-7 tries for 4 to 6 letters, one more per two letters beyond that
+// and one fewer for 3 letters, so long words stay winnable and short ones a challenge
+func MaxTriesRange(wordSize int) (int, int) {
+	minTries := 5 + (wordSize-5)/2
+	return minTries, minTries + 2
+}
+
 // CreateGameState is a factory function that creates a new game state with validation
 // Game Rule: Target word is randomly selected and not exposed to client
-func CreateGameState(maxTries int, wordSize int) (GameState, error) {
-	if maxTries < 5 || maxTries > 7 {
-		return GameState{}, fmt.Errorf("maxTries must be between 5 and 7, got %d", maxTries)
+// Game Rule: The per-guess clock starts as soon as a speed game is created
+// Game Rule: Daily games share one deterministic word per date, language and word size
+// Game Rule: maxTries is chosen per board; multi-board games get one more try per extra board
+// Game Rule: Speed games always record a seed; the same seed, word size and
+// answer list version pick the same words again
+func CreateGameState(options GameOptions) (GameState, error) {
+	if options.Mode == "challenge" && options.Challenge != nil {
+		// Challenge games are played on the creator's settings
+		options.Language = options.Challenge.Language
+		options.WordSize = options.Challenge.WordSize
+		options.MaxTries = options.Challenge.MaxTries
+		options.HardMode = options.Challenge.HardMode
 	}
-	if wordSize < 4 || wordSize > 6 {
-		return GameState{}, fmt.Errorf("wordSize must be between 4 and 6, got %d", wordSize)
+	if options.Language == "" {
+		options.Language = data.DefaultLanguage
 	}
-	
-	now := time.Now()
-	
-	// Generate random target word from appropriate word list
-	randomWord := helpers.GetRandomWord(wordSize)
-	nextID, err := helpers.GetNextID("game_states")
-	if err != nil {
+	if options.Boards == 0 {
+		options.Boards = 1
+	}
+	if err := data.CheckWordSize(options.Language, options.WordSize); err != nil {
 		return GameState{}, err
 	}
+	minTries, maxTries := MaxTriesRange(options.WordSize)
+	if options.MaxTries < minTries || options.MaxTries > maxTries {
+		return GameState{}, fmt.Errorf("maxTries must be between %d and %d for %d-letter words, got %d", minTries, maxTries, options.WordSize, options.MaxTries)
+	}
+	if err := CheckBoardCount(options.Boards); err != nil {
+		return GameState{}, err
+	}
+	if options.Seed != nil && options.Mode != "" && options.Mode != "speed" {
+		return GameState{}, fmt.Errorf("seed only applies to speed games, got mode %q", options.Mode)
+	}
+	
+	now := time.Now()
 	
 	// Create new game state with default values
-	return GameState{
-		ID:         nextID,
-		TargetWord: randomWord, // Server-side only, not sent to client
+	// ID is assigned by the database when the game is saved
+	gameState := GameState{
 		Tries: []GuessResult{}, // Empty slice for new game
-		GameStatus: "playing",
-		Mode: "speed", // Default to API-based mode
-		MaxTries:   maxTries,
-		WordSize:   wordSize,
+		GameStatus: StatusPlaying,
+		MaxTries:   options.MaxTries + options.Boards - 1,
+		WordSize:   options.WordSize,
+		Language:   options.Language,
+		UserID:     options.UserID,
+		HardMode:   options.HardMode,
 		CreatedAt:  now,
 		UpdatedAt:  now,
-	}, nil
-}
-
-func SaveGameState(gameState GameState) error {
-	triesJSON, err := json.Marshal(gameState.Tries)
-	if err != nil {
-		return fmt.Errorf("failed to marshal tries to JSON: %v", err)
 	}
 	
-	query := `
-		INSERT INTO game_states (id, target_word, tries, game_status, mode, max_tries, word_size, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`
-	
-	_, err = database.DB.Exec(query, gameState.ID, gameState.TargetWord, string(triesJSON), gameState.GameStatus, gameState.Mode, gameState.MaxTries, gameState.WordSize, gameState.CreatedAt, gameState.UpdatedAt)
-	if err != nil {
-		return fmt.Errorf("failed to save game state: %v", err)
+	switch options.Mode {
+	case "", "speed":
+		if options.TimeLimit < 15 || options.TimeLimit > 120 {
+			return GameState{}, fmt.Errorf("timeLimit must be between 15 and 120 seconds, got %d", options.TimeLimit)
+		}
+		gameState.Seed = helpers.NewSeed()
+		if options.Seed != nil {
+			if *options.Seed < 0 || *options.Seed > helpers.MaxSeed {
+				return GameState{}, fmt.Errorf("seed must be between 0 and %d, got %d", int64(helpers.MaxSeed), *options.Seed)
+			}
+			gameState.Seed = *options.Seed
+			gameState.Seeded = true
+		}
+		listVersion, err := helpers.GetAnswerListVersion(options.Language, options.WordSize)
+		if err != nil {
+			return GameState{}, err
+		}
+		gameState.ListVersion = listVersion
+		
+		rng := helpers.NewRNG(gameState.Seed)
+		if options.Boards > 1 {
+			targetWords, err := helpers.GetRandomWords(rng, options.Language, options.WordSize, options.Boards)
+			if err != nil {
+				return GameState{}, err
+			}
+			gameState.Boards = newBoards(targetWords) // Server-side only, each target is revealed once solved
+		} else {
+			targetWord, err := helpers.GetRandomWord(rng, options.Language, options.WordSize)
+			if err != nil {
+				return GameState{}, err
+			}
+			gameState.TargetWord = targetWord // Server-side only, not sent to client
+		}
+		gameState.Mode = "speed"
+		gameState.TimeLimit = options.TimeLimit
+		gameState.GuessDeadline = now.Add(time.Duration(options.TimeLimit) * time.Second)
+	case "daily":
+		if options.UserID == 0 {
+			return GameState{}, ErrDailyRequiresPlayer
+		}
+		if options.Boards > 1 {
+			return GameState{}, fmt.Errorf("daily puzzles have a single board, got boards %d", options.Boards)
+		}
+		dailyDate := FormatDailyDate(now)
+		targetWord, err := helpers.GetDailyWord(options.Language, options.WordSize, dailyDate)
+		if err != nil {
+			return GameState{}, err
+		}
+		gameState.Mode = "daily"
+		gameState.TargetWord = targetWord
+		gameState.DailyDate = dailyDate
+	case "absurdle":
+		if options.Boards > 1 {
+			return GameState{}, fmt.Errorf("absurdle games have a single board, got boards %d", options.Boards)
+		}
+		candidates, err := helpers.GetAnswerWords(options.Language, options.WordSize)
+		if err != nil {
+			return GameState{}, err
+		}
+		gameState.Mode = "absurdle"
+		gameState.Candidates = candidates // TargetWord is set once one candidate is left
+	case "challenge":
+		if options.Challenge == nil {
+			return GameState{}, ErrChallengeNotFound
+		}
+		if options.UserID == 0 {
+			return GameState{}, ErrChallengeRequiresPlayer
+		}
+		if options.UserID == options.Challenge.CreatorID {
+			return GameState{}, ErrOwnChallenge
+		}
+		if options.Boards > 1 {
+			return GameState{}, fmt.Errorf("challenge games have a single board, got boards %d", options.Boards)
+		}
+		gameState.Mode = "challenge"
+		gameState.TargetWord = options.Challenge.TargetWord // Never revealed, see routes.GameStateResponse
+		gameState.ChallengeID = options.Challenge.ID
+	default:
+		return GameState{}, fmt.Errorf("mode must be \"speed\", \"daily\", \"absurdle\" or \"challenge\", got %q", options.Mode)
 	}
 	
+	return gameState, nil
+}
+
+// SaveGameState inserts a new game and sets gameState.ID
+// Returns ErrDailyAlreadyPlayed for a second game of the same daily puzzle,
+// ErrChallengeAlreadyPlayed for a second game of the same challenge
+func SaveGameState(gameState *GameState) error {
+	return Games.CreateGame(gameState)
+}
+
+// SaveGuestGameState saves the first game of an anonymous player together with a new
+// guest account that owns it, and returns the guest's bearer token
+// Only called once the game is valid, so rejected requests create no guest
+func SaveGuestGameState(gameState *GameState) (string, error) {
+	return Games.CreateGuestGame(gameState)
+}
+
+// GameStateFilter narrows and pages a game state listing
+// DESIGN DECISION: Keyset pagination on id instead of OFFSET so deep pages stay cheap
+// and rows inserted while paging are neither skipped nor repeated
+// Order can be: "desc" (newest first, default) or "asc"
+type GameStateFilter struct {
+	GameStatus    string
+	Mode          string
+	WordSize      int
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Order         string
+	AfterID       int64 // Cursor: last id of the previous page, 0 for the first page
+	Limit         int
+}
+
+// Validate checks filter values and fills in defaults
+func (f *GameStateFilter) Validate() error {
+	if f.Order == "" {
+		f.Order = "desc"
+	}
+	if f.Order != "asc" && f.Order != "desc" {
+		return fmt.Errorf("order must be asc or desc, got %q", f.Order)
+	}
+	if f.Limit == 0 {
+		f.Limit = 20
+	}
+	if f.Limit < 1 || f.Limit > 100 {
+		return fmt.Errorf("limit must be between 1 and 100, got %d", f.Limit)
+	}
+	if f.AfterID < 0 {
+		return fmt.Errorf("cursor must not be negative")
+	}
+	if !f.CreatedAfter.IsZero() && !f.CreatedBefore.IsZero() && f.CreatedAfter.After(f.CreatedBefore) {
+		return fmt.Errorf("createdAfter must not be later than createdBefore")
+	}
 	return nil
 }
 
-func GetAllGameStates() ([]GameState, error) {
-	var gameStates []GameState
-	
-	query := `
-		SELECT id, target_word, tries, game_status, mode, max_tries, word_size, created_at, updated_at
-		FROM game_states
-	`
-	
-	rows, err := database.DB.Query(query)
-	if err != nil {
-		return []GameState{}, fmt.Errorf("failed to get all game states: %v", err)
+// ListGameStates returns one page of game states matching the filter
+// nextCursor is the id to resume from, or 0 when there are no more rows
+// The filter must have been validated; overdue games are timed out first
+func ListGameStates(filter GameStateFilter) ([]GameState, int64, error) {
+	if err := TimeoutOverdueGames(time.Now()); err != nil {
+		return []GameState{}, 0, err
 	}
-	defer rows.Close()
-	
-	for rows.Next() {
-		var gameState GameState
-		var triesJSON string
-		
-		err := rows.Scan(
-			&gameState.ID,
-			&gameState.TargetWord,
-			&triesJSON,
-			&gameState.GameStatus,
-			&gameState.Mode,
-			&gameState.MaxTries,
-			&gameState.WordSize,
-			&gameState.CreatedAt,
-			&gameState.UpdatedAt,
-		)
-		if err != nil {
-			return []GameState{}, fmt.Errorf("failed to scan game state: %v", err)
-		}
-		
-		if err := json.Unmarshal([]byte(triesJSON), &gameState.Tries); err != nil {
-			return []GameState{}, fmt.Errorf("failed to unmarshal tries: %v", err)
-		}
-		
-		gameStates = append(gameStates, gameState)
+	return Games.ListGames(filter)
+}
+
+// trimPage drops the extra game fetched to detect a next page
+// Stores fetch limit+1 games; the cursor is the last id kept, or 0 on the last page
+func trimPage(gameStates []GameState, limit int) ([]GameState, int64) {
+	if len(gameStates) <= limit {
+		return gameStates, 0
 	}
-	
-	return gameStates, nil
+	gameStates = gameStates[:limit]
+	return gameStates, gameStates[len(gameStates)-1].ID
 }
 
 func GetGameStateByID(gameStateID int64) (GameState, error) {
-	var gameState GameState
-	
-	query := `
-		SELECT id, target_word, tries, game_status, mode, max_tries, word_size, created_at, updated_at
-		FROM game_states 
-		WHERE id = ?
-	`
-	
-	row := database.DB.QueryRow(query, gameStateID)
-	var triesJSON string
-	
-	err := row.Scan(
-		&gameState.ID,
-		&gameState.TargetWord,
-		&triesJSON,
-		&gameState.GameStatus,
-		&gameState.Mode,
-		&gameState.MaxTries,
-		&gameState.WordSize,
-		&gameState.CreatedAt,
-		&gameState.UpdatedAt,
-	)
+	return Games.GetGame(gameStateID)
+}
+
+// PlayGuess records a guess as one atomic read-validate-append
+// CONCURRENCY: Optimistic locking on the version column. The caller passes the version
+// it based its decision on; if another request changed the game since, nothing is
+// written and ErrStaleGameState is returned so two rapid submissions cannot both land
+func PlayGuess(gameStateID int64, expectedVersion int, guessWord string, now time.Time) (GameState, error) {
+	return Games.AppendGuess(gameStateID, expectedVersion, func(gameState *GameState) error {
+		_, err := gameState.ApplyGuess(guessWord, now)
+		return err
+	})
+}
+
+// NormalizeGuess spells a guess the way the game's word lists do
+// Unicode uppercase plus the language's diacritic folding, see data.Language
+func (gs *GameState) NormalizeGuess(guessWord string) string {
+	language, err := data.GetLanguage(gs.Language)
 	if err != nil {
-		return GameState{}, fmt.Errorf("failed to load game state: %v", err)
-	}
-	
-	if err := json.Unmarshal([]byte(triesJSON), &gameState.Tries); err != nil {
-		return GameState{}, fmt.Errorf("failed to unmarshal tries: %v", err)
+		return strings.ToUpper(strings.TrimSpace(guessWord))
 	}
-	
-	return gameState, nil
+	return language.Normalize(guessWord)
 }
 
-func UpdateGameState(gameState GameState) error {
-	existingGameState, err := GetGameStateByID(gameState.ID)
-	if err != nil {
-		return fmt.Errorf("failed to get existing game state: %v", err)
+// ApplyGuess scores a guess against the target word, or every unsolved board, and records it
+// Enforces game status, word length and hard mode before scoring; does not persist anything
+// The guess is normalized first, so the recorded guess matches the word list spelling
+func (gs *GameState) ApplyGuess(guessWord string, now time.Time) (GuessResult, error) {
+	guessWord = gs.NormalizeGuess(guessWord)
+	if gs.GameStatus != StatusPlaying {
+		return GuessResult{}, &GameOverError{Status: gs.GameStatus}
 	}
-	
-	existingGameState.Tries = append(existingGameState.Tries, gameState.Tries...)
-	
-	existingGameState.GameStatus = gameState.GameStatus
-	existingGameState.UpdatedAt = time.Now()
-	
-	triesJSON, err := json.Marshal(existingGameState.Tries)
-	if err != nil {
-		return fmt.Errorf("failed to marshal tries to JSON: %v", err)
+	if err := gs.CheckGuessLength(guessWord); err != nil {
+		return GuessResult{}, err
+	}
+	if err := gs.CheckHardMode(guessWord); err != nil {
+		return GuessResult{}, err
 	}
 	
-	query := `
-		UPDATE game_states 
-		SET tries = ?, game_status = ?, updated_at = ?
-		WHERE id = ?
-	`
+	var guessResult GuessResult
+	isWon := false
+	if gs.IsMultiBoard() {
+		guessResult = gs.scoreBoards(guessWord)
+		isWon = gs.AllBoardsSolved()
+	} else if gs.Mode == "absurdle" {
+		var err error
+		if guessResult, err = gs.dodgeGuess(guessWord); err != nil {
+			return GuessResult{}, err
+		}
+		isWon = guessResult.IsCorrect
+	} else {
+		guessResult = GuessResult{
+			GuessWord:         guessWord,
+			LetterResultArray: ValidateGuess(guessWord, gs.TargetWord),
+			IsCorrect:         strings.EqualFold(guessWord, gs.TargetWord),
+		}
+		isWon = guessResult.IsCorrect
+	}
 	
-	_, err = database.DB.Exec(query, string(triesJSON), existingGameState.GameStatus, existingGameState.UpdatedAt, existingGameState.ID)
-	if err != nil {
-		return fmt.Errorf("failed to update game state: %v", err)
+	if newStatus := gs.DetermineGameStatus(isWon); newStatus != gs.GameStatus {
+		if err := gs.TransitionTo(newStatus); err != nil {
+			return GuessResult{}, err
+		}
+		if newStatus == StatusLost {
+			gs.loseBoards()
+			gs.commitCandidate()
+		}
 	}
+	gs.Tries = append(gs.Tries, guessResult)
+	gs.GuessDeadline = gs.NextGuessDeadline(now)
+	gs.UpdatedAt = now
 	
-	return nil
+	return guessResult, nil
 }
 
 // ValidateGuess implements the core Wordle game logic for evaluating guesses
@@ -226,22 +414,25 @@ func UpdateGameState(gameState GameState) error {
 //
 // BUSINESS RULE: Each letter in target word can only be matched once
 // This prevents over-counting duplicate letters in guesses
+//
+// Words are compared letter by letter as runes, not bytes, so letters such as Ñ or Ü
+// that take several bytes in UTF-8 line up; both words should already be normalized
+// the same way (see GameState.NormalizeGuess)
 func ValidateGuess(guessWord string, targetWord string) []LetterResult {
-	letterResultArray := make([]LetterResult, len(guessWord))
-	
 	// Normalize to uppercase for consistent comparison
-	guessWord = strings.ToUpper(guessWord)
-	targetWord = strings.ToUpper(targetWord)
+	guessLetters := []rune(strings.ToUpper(guessWord))
+	targetLetters := []rune(strings.ToUpper(targetWord))
+	letterResultArray := make([]LetterResult, len(guessLetters))
 	
 	// Count available letters in target word for yellow letter logic
 	targetLetterCounts := make(map[rune]int)
-	for _, letter := range targetWord {
+	for _, letter := range targetLetters {
 		targetLetterCounts[letter]++
 	}
 	
 	// First pass: Mark exact position matches (green squares)
-	for i, letter := range guessWord {
-		if i < len(targetWord) && rune(targetWord[i]) == letter {
+	for i, letter := range guessLetters {
+		if i < len(targetLetters) && targetLetters[i] == letter {
 			letterResultArray[i] = LetterResult{
 				Letter: string(letter),
 				Status: "correct",
@@ -252,7 +443,7 @@ func ValidateGuess(guessWord string, targetWord string) []LetterResult {
 	
 	// Second pass: Mark position mismatches (yellow squares)
 	// Only mark as yellow if letter exists in target and hasn't been over-consumed
-	for i, letter := range guessWord {
+	for i, letter := range guessLetters {
 		if letterResultArray[i].Status == "" { // Skip already marked letters
 			if targetLetterCounts[letter] > 0 {
 				letterResultArray[i] = LetterResult{
@@ -274,51 +465,73 @@ func ValidateGuess(guessWord string, targetWord string) []LetterResult {
 
 func (gs *GameState) DetermineGameStatus(isCorrect bool) string {
 	if isCorrect {
-		return "won"
+		return StatusWon
 	} else {
 		if len(gs.Tries)+1 >= gs.MaxTries {
-			return "lost"
+			return StatusLost
 		} else {
 			return gs.GameStatus
 		}
 	}
 }
 
+// IsPastGuessDeadline reports whether the per-guess clock ran out before now
+// SECURITY: Enforced server-side so a client cannot dodge a timeout by never reporting it
+func (gs *GameState) IsPastGuessDeadline(now time.Time) bool {
+	if gs.GuessDeadline.IsZero() {
+		return false
+	}
+	return now.After(gs.GuessDeadline.Add(GuessDeadlineGrace))
+}
+
+// NextGuessDeadline restarts the per-guess clock from the given instant
+// Games without a time limit (e.g. daily) never get a deadline
+func (gs *GameState) NextGuessDeadline(from time.Time) time.Time {
+	if gs.TimeLimit == 0 {
+		return time.Time{}
+	}
+	return from.Add(time.Duration(gs.TimeLimit) * time.Second)
+}
+
+// IsOwnedBy reports whether the given player may act on this game
+// SECURITY: Anonymous games (no owner) stay open to any client for backwards compatibility
+func (gs *GameState) IsOwnedBy(userID int64) bool {
+	return gs.UserID == 0 || gs.UserID == userID
+}
+
+// LeaveGameState ends the game as abandoned; leaving twice is a no-op
 func (gs *GameState) LeaveGameState() error {
-	updatedAt := time.Now()
-	
-	query := `
-		UPDATE game_states 
-		SET game_status = ?, updated_at = ?
-		WHERE id = ?
-	`
-	
-	_, err := database.DB.Exec(query, "lost", updatedAt, gs.ID)
-	if err != nil {
-		return fmt.Errorf("failed to leave game state: %v", err)
+	if gs.GameStatus == StatusAbandoned {
+		return nil
 	}
-	
-	return nil
+	return gs.finish(StatusAbandoned)
 }
 
+// TimeoutGameState ends the game as timed out; timing out twice is a no-op
+// so a client timer racing the server-side deadline check does not fail
 func (gs *GameState) TimeoutGameState() error {
-	updatedAt := time.Now()
-	
-	query := `
-		UPDATE game_states 
-		SET game_status = ?, updated_at = ?
-		WHERE id = ?
-	`
-	
-	_, err := database.DB.Exec(query, "timeout", updatedAt, gs.ID)
-	if err != nil {
-		return fmt.Errorf("failed to set game state status to timeout: %v", err)
+	if gs.GameStatus == StatusTimeout {
+		return nil
 	}
-	
-	gs.GameStatus = "timeout"
-	gs.UpdatedAt = updatedAt
-	
-	return nil
+	return gs.finish(StatusTimeout)
 }
 
+// TimeoutOverdueGames times out every playing game whose guess deadline, plus
+// GuessDeadlineGrace, passed before now
+// BUSINESS RULE: A player who walks away from a timed game loses it by timeout, even though
+// no further guess arrives to notice; lists and aggregates sweep first so they count it
+// CONCURRENCY: A game another request played on or ended since it was listed is skipped,
+// that request has already decided it
+func TimeoutOverdueGames(now time.Time) error {
+	gameStates, err := Games.ListOverdueGames(now.Add(-GuessDeadlineGrace))
+	if err != nil {
+		return err
+	}
 
+	for _, gameState := range gameStates {
+		if err := gameState.TimeoutGameState(); err != nil && !errors.Is(err, ErrStaleGameState) {
+			return fmt.Errorf("failed to time out game state %d: %v", gameState.ID, err)
+		}
+	}
+	return nil
+}
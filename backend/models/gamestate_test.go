@@ -0,0 +1,144 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestValidateGuess(t *testing.T) {
+	tests := []struct {
+		guess   string
+		target  string
+		pattern string
+	}{
+		{"CRANE", "CRANE", "CCCCC"},
+		{"SLATE", "CRANE", "AACAC"},
+		{"TRACE", "CRANE", "ACCPC"},
+		// Each target letter is matched once: only one of the guessed E's can score
+		{"EERIE", "CRANE", "AAPAC"},
+		{"SPEED", "ABIDE", "AAPAP"},
+		// Multi-byte letters line up by rune, not by byte
+		{"AÑEJO", "AÑEJO", "CCCCC"},
+		{"NIÑOS", "AÑEJO", "AAPPA"},
+		{"ÄRGER", "ÄPFEL", "CAACA"},
+	}
+
+	for _, test := range tests {
+		result := GuessResult{LetterResultArray: ValidateGuess(test.guess, test.target)}
+		if pattern := result.Pattern(); pattern != test.pattern {
+			t.Errorf("ValidateGuess(%s, %s) = %s, want %s", test.guess, test.target, pattern, test.pattern)
+		}
+	}
+}
+
+func TestCheckHints(t *testing.T) {
+	tries := []GuessResult{
+		{GuessWord: "TRACE", LetterResultArray: ValidateGuess("TRACE", "CRANE")},
+	}
+
+	tests := []struct {
+		guess string
+		rule  string // Empty when the guess keeps every hint
+	}{
+		{"CRANE", ""},
+		{"CRAZE", ""},
+		{"CRATE", ""},
+		{"MOODY", "correct-position"},
+		{"BRAKE", "must-contain"},
+	}
+
+	for _, test := range tests {
+		err := checkHints(tries, test.guess)
+		if test.rule == "" {
+			if err != nil {
+				t.Errorf("checkHints(%s) = %v, want nil", test.guess, err)
+			}
+			continue
+		}
+		var violation *HardModeViolation
+		if !errors.As(err, &violation) || violation.Rule != test.rule {
+			t.Errorf("checkHints(%s) = %v, want %s violation", test.guess, err, test.rule)
+		}
+	}
+}
+
+func TestCheckHintsCountsDuplicates(t *testing.T) {
+	tries := []GuessResult{
+		{GuessWord: "EERIE", LetterResultArray: ValidateGuess("EERIE", "EMBED")},
+	}
+
+	var violation *HardModeViolation
+	if err := checkHints(tries, "EARTH"); !errors.As(err, &violation) || violation.Letter != "E" || violation.Count != 2 {
+		t.Fatalf("checkHints(EARTH) = %v, want E at least 2 times", err)
+	}
+	if err := checkHints(tries, "EMBED"); err != nil {
+		t.Fatalf("checkHints(EMBED) = %v, want nil", err)
+	}
+}
+
+func TestDodgeGuessKeepsLargestBucket(t *testing.T) {
+	gameState := GameState{Mode: "absurdle", Candidates: []string{"CRANE", "CRATE", "GRATE", "MOODY"}}
+
+	// SLATE scores CRATE and GRATE alike (AACCC), CRANE and MOODY each differently,
+	// so the two-word bucket wins over the singletons
+	result, err := gameState.dodgeGuess("SLATE")
+	if err != nil {
+		t.Fatalf("dodgeGuess(SLATE): %v", err)
+	}
+	if result.IsCorrect {
+		t.Fatalf("dodgeGuess(SLATE) was correct with %d candidates left", len(gameState.Candidates))
+	}
+	if !slices.Equal(gameState.Candidates, []string{"CRATE", "GRATE"}) {
+		t.Fatalf("candidates = %v, want [CRATE GRATE]", gameState.Candidates)
+	}
+	if gameState.TargetWord != "" {
+		t.Fatalf("target committed to %s with two candidates left", gameState.TargetWord)
+	}
+
+	// GRATE and CRATE score differently on CRATE, so one of them must be left
+	if _, err := gameState.dodgeGuess("CRATE"); err != nil {
+		t.Fatalf("dodgeGuess(CRATE): %v", err)
+	}
+	if len(gameState.Candidates) != 1 || gameState.TargetWord != gameState.Candidates[0] {
+		t.Fatalf("after CRATE: candidates %v, target %q", gameState.Candidates, gameState.TargetWord)
+	}
+}
+
+func TestDodgeGuessWithoutCandidates(t *testing.T) {
+	gameState := GameState{Mode: "absurdle", GameStatus: StatusPlaying, WordSize: 5, MaxTries: 6}
+
+	if _, err := gameState.ApplyGuess("SLATE", time.Now()); !errors.Is(err, ErrNoCandidates) {
+		t.Fatalf("ApplyGuess without candidates error = %v, want ErrNoCandidates", err)
+	}
+	if len(gameState.Tries) != 0 {
+		t.Fatalf("guess was recorded on a game without candidates")
+	}
+}
+
+func TestApplyGuessScoresEveryUnsolvedBoard(t *testing.T) {
+	gameState := newTestGameState()
+	gameState.TargetWord = ""
+	gameState.TimeLimit = 0
+	gameState.MaxTries = 7
+	gameState.Boards = newBoards([]string{"CRANE", "MOODY"})
+
+	result, err := gameState.ApplyGuess("crane", time.Now())
+	if err != nil {
+		t.Fatalf("ApplyGuess(crane): %v", err)
+	}
+	if !result.IsCorrect || gameState.GameStatus != StatusPlaying {
+		t.Fatalf("after solving one board: correct %v, status %s", result.IsCorrect, gameState.GameStatus)
+	}
+	if gameState.Boards[0].Status != StatusWon || len(gameState.Boards[1].Tries) != 1 {
+		t.Fatalf("boards after CRANE: %+v", gameState.Boards)
+	}
+
+	if _, err := gameState.ApplyGuess("moody", time.Now()); err != nil {
+		t.Fatalf("ApplyGuess(moody): %v", err)
+	}
+	if gameState.GameStatus != StatusWon || len(gameState.Boards[0].Tries) != 1 {
+		t.Fatalf("after solving both boards: status %s, solved board has %d tries", gameState.GameStatus, len(gameState.Boards[0].Tries))
+	}
+}
@@ -0,0 +1,212 @@
+// Guest Sessions - Anonymous players who may register later
+//
+// ARCHITECTURE DECISION: Guests are rows in the users table flagged is_guest
+// - Games reference a guest exactly like a registered account, so ownership,
+//   daily limits and history work without special cases
+// - Claiming re-points game_states.user_id from the guest to the real account,
+//   along with the challenges and races the guest created, joined or won
+//
+// TRADE-OFFS CONSIDERED:
+// - Upgrade in place vs Claim: Claim chosen so an existing account can absorb
+//   games played on another device as a guest
+package models
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+	"wordle-backend/database"
+)
+
+// ClaimResult reports how many guest games moved to the account
+// SkippedDailyGames counts daily puzzles the account had already played,
+// SkippedChallengeGames challenges it had already played and SkippedRaces races
+// it played in against the guest; those stay with the guest since an account
+// keeps one result per puzzle, per challenge and per race
+type ClaimResult struct {
+	ClaimedGames          int64 `json:"claimedGames"`
+	SkippedDailyGames     int64 `json:"skippedDailyGames"`
+	SkippedChallengeGames int64 `json:"skippedChallengeGames"`
+	SkippedRaces          int64 `json:"skippedRaces"`
+}
+
+// CreateGuestUser creates an anonymous account and a bearer token for it
+// Games of new guests are saved with SaveGuestGameState instead, which creates both at once
+func CreateGuestUser() (User, string, error) {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return User{}, "", fmt.Errorf("failed to begin creating guest user: %v", err)
+	}
+	defer tx.Rollback()
+	
+	user, token, err := createGuestUser(tx)
+	if err != nil {
+		return User{}, "", err
+	}
+	
+	if err := tx.Commit(); err != nil {
+		return User{}, "", fmt.Errorf("failed to commit guest user: %v", err)
+	}
+	
+	return user, token, nil
+}
+
+// createGuestUser inserts a guest account and its session inside tx
+func createGuestUser(tx *sql.Tx) (User, string, error) {
+	suffix, err := randomHex(8)
+	if err != nil {
+		return User{}, "", fmt.Errorf("failed to generate guest name: %v", err)
+	}
+	
+	user := User{
+		Username:  "guest-" + suffix,
+		IsGuest:   true,
+		CreatedAt: time.Now(),
+	}
+	
+	query := `
+		INSERT INTO users (username, password_hash, is_guest, created_at)
+		VALUES (?, '', TRUE, ?)
+		RETURNING id
+	`
+	
+	err = tx.QueryRow(database.Driver.Rebind(query), user.Username, user.CreatedAt).Scan(&user.ID)
+	if err != nil {
+		return User{}, "", fmt.Errorf("failed to save guest user: %v", err)
+	}
+	
+	token, err := createSession(tx, user.ID, GuestSessionDuration)
+	if err != nil {
+		return User{}, "", err
+	}
+	
+	return user, token, nil
+}
+
+// ClaimGuestGames moves every game owned by the guest behind guestToken to userID
+// The guest token is revoked afterwards so the games cannot be played twice
+func ClaimGuestGames(userID int64, guestToken string) (ClaimResult, error) {
+	user, err := GetUserByID(userID)
+	if err != nil {
+		return ClaimResult{}, err
+	}
+	if user.IsGuest {
+		return ClaimResult{}, ErrGuestCannotClaim
+	}
+	
+	guestID, err := GetUserIDBySessionToken(guestToken)
+	if err != nil {
+		return ClaimResult{}, err
+	}
+	guest, err := GetUserByID(guestID)
+	if err != nil {
+		return ClaimResult{}, err
+	}
+	if !guest.IsGuest {
+		return ClaimResult{}, ErrNotGuest
+	}
+	
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to begin claim: %v", err)
+	}
+	defer tx.Rollback()
+	
+	// Daily puzzles and challenges the account already played would break the one-game rules
+	moveQuery := `
+		UPDATE game_states
+		SET user_id = ?
+		WHERE user_id = ?
+		AND NOT (mode = 'daily' AND EXISTS (
+			SELECT 1 FROM game_states owned
+			WHERE owned.user_id = ?
+			AND owned.mode = 'daily'
+			AND owned.daily_date = game_states.daily_date
+			AND owned.language = game_states.language
+			AND owned.word_size = game_states.word_size
+		))
+		AND NOT (challenge_id IS NOT NULL AND EXISTS (
+			SELECT 1 FROM game_states owned
+			WHERE owned.user_id = ?
+			AND owned.challenge_id = game_states.challenge_id
+		))
+	`
+	
+	moved, err := tx.Exec(database.Driver.Rebind(moveQuery), userID, guestID, userID, userID)
+	if err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to claim guest games: %v", err)
+	}
+	
+	var result ClaimResult
+	result.ClaimedGames, err = moved.RowsAffected()
+	if err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to count claimed games: %v", err)
+	}
+	
+	skippedQuery := `
+		SELECT
+			COALESCE(SUM(CASE WHEN mode = 'daily' THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN challenge_id IS NOT NULL THEN 1 ELSE 0 END), 0)
+		FROM game_states
+		WHERE user_id = ?
+	`
+	err = tx.QueryRow(database.Driver.Rebind(skippedQuery), guestID).Scan(&result.SkippedDailyGames, &result.SkippedChallengeGames)
+	if err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to count skipped games: %v", err)
+	}
+	
+	// Challenges the guest created keep their results visible to the account
+	if _, err := tx.Exec(database.Driver.Rebind(`UPDATE challenges SET creator_id = ? WHERE creator_id = ?`), userID, guestID); err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to claim guest challenges: %v", err)
+	}
+	
+	result.SkippedRaces, err = claimGuestRaces(tx, userID, guestID)
+	if err != nil {
+		return ClaimResult{}, err
+	}
+	
+	if _, err := tx.Exec(database.Driver.Rebind(`DELETE FROM sessions WHERE user_id = ?`), guestID); err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to revoke guest sessions: %v", err)
+	}
+	
+	if err := tx.Commit(); err != nil {
+		return ClaimResult{}, fmt.Errorf("failed to commit claim: %v", err)
+	}
+	
+	return result, nil
+}
+
+// claimGuestRaces moves the guest's races, their guesses and the races it hosted or won
+// to userID inside the claim's transaction, returning how many races were skipped
+// A race both of them played in stays with the guest, since a race has one row per player
+func claimGuestRaces(tx *sql.Tx, userID int64, guestID int64) (int64, error) {
+	// Every statement checks the account's race_players rows, so race_players must move last
+	notPlayedByAccount := `NOT EXISTS (
+			SELECT 1 FROM race_players owned
+			WHERE owned.user_id = ?
+			AND owned.race_id = %s
+		)`
+	
+	statements := []struct {
+		query  string
+		action string
+	}{
+		{`UPDATE races SET host_id = ? WHERE host_id = ? AND ` + fmt.Sprintf(notPlayedByAccount, "races.id"), "hosted races"},
+		{`UPDATE races SET winner_id = ? WHERE winner_id = ? AND ` + fmt.Sprintf(notPlayedByAccount, "races.id"), "won races"},
+		{`UPDATE race_guesses SET user_id = ? WHERE user_id = ? AND ` + fmt.Sprintf(notPlayedByAccount, "race_guesses.race_id"), "race guesses"},
+		{`UPDATE race_players SET user_id = ? WHERE user_id = ? AND ` + fmt.Sprintf(notPlayedByAccount, "race_players.race_id"), "races"},
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(database.Driver.Rebind(statement.query), userID, guestID, userID); err != nil {
+			return 0, fmt.Errorf("failed to claim guest %s: %v", statement.action, err)
+		}
+	}
+	
+	var skipped int64
+	err := tx.QueryRow(database.Driver.Rebind(`SELECT COUNT(*) FROM race_players WHERE user_id = ?`), guestID).Scan(&skipped)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count skipped races: %v", err)
+	}
+	
+	return skipped, nil
+}
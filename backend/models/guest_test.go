@@ -0,0 +1,102 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// saveTestRace saves a CRANE race hosted by hostID that the other players have joined
+func saveTestRace(t *testing.T, code string, hostID int64, playerIDs ...int64) Race {
+	t.Helper()
+	race := Race{
+		Code:       code,
+		HostID:     hostID,
+		TargetWord: "CRANE",
+		Language:   "en",
+		WordSize:   5,
+		MaxTries:   6,
+		MaxPlayers: MaxRacePlayers,
+		Status:     RaceWaiting,
+		CreatedAt:  time.Now(),
+	}
+	if err := SaveRace(&race); err != nil {
+		t.Fatalf("SaveRace: %v", err)
+	}
+	for _, playerID := range playerIDs {
+		if _, err := JoinRace(code, playerID); err != nil {
+			t.Fatalf("JoinRace: %v", err)
+		}
+	}
+	return race
+}
+
+// racePlayerIDs returns the IDs of a race's players in joining order
+func racePlayerIDs(t *testing.T, code string) []int64 {
+	t.Helper()
+	race, err := GetRaceByCode(code)
+	if err != nil {
+		t.Fatalf("GetRaceByCode(%s): %v", code, err)
+	}
+	ids := []int64{}
+	for _, player := range race.Players {
+		ids = append(ids, player.UserID)
+	}
+	return ids
+}
+
+func TestClaimGuestGamesMovesRaces(t *testing.T) {
+	openTestDB(t)
+
+	guest, guestToken, err := CreateGuestUser()
+	if err != nil {
+		t.Fatalf("CreateGuestUser: %v", err)
+	}
+	opponent, _, err := CreateGuestUser()
+	if err != nil {
+		t.Fatalf("CreateGuestUser: %v", err)
+	}
+	account, err := RegisterUser("player1", "correct horse")
+	if err != nil {
+		t.Fatalf("RegisterUser: %v", err)
+	}
+
+	// The guest hosts and wins one race, and plays another against the account
+	won := saveTestRace(t, "guestrace", guest.ID, opponent.ID)
+	if _, err := StartRace(won.Code, guest.ID); err != nil {
+		t.Fatalf("StartRace: %v", err)
+	}
+	if _, err := PlayRaceGuess(won.ID, guest.ID, "CRANE", time.Now()); err != nil {
+		t.Fatalf("PlayRaceGuess: %v", err)
+	}
+	saveTestRace(t, "sharedrace", account.ID, guest.ID)
+
+	result, err := ClaimGuestGames(account.ID, guestToken)
+	if err != nil {
+		t.Fatalf("ClaimGuestGames: %v", err)
+	}
+	if result.SkippedRaces != 1 {
+		t.Fatalf("skipped %d races, want 1", result.SkippedRaces)
+	}
+
+	race, err := GetRaceByCode(won.Code)
+	if err != nil {
+		t.Fatalf("GetRaceByCode: %v", err)
+	}
+	if race.HostID != account.ID || race.WinnerID != account.ID {
+		t.Fatalf("claimed race host %d, winner %d, want %d for both", race.HostID, race.WinnerID, account.ID)
+	}
+	if players := racePlayerIDs(t, won.Code); players[0] != account.ID || players[1] != opponent.ID {
+		t.Fatalf("claimed race players = %v, want [%d %d]", players, account.ID, opponent.ID)
+	}
+	guesses, err := GetRaceGuesses(won.ID)
+	if err != nil {
+		t.Fatalf("GetRaceGuesses: %v", err)
+	}
+	if len(guesses) != 1 || guesses[0].UserID != account.ID {
+		t.Fatalf("claimed race guesses = %+v, want one by %d", guesses, account.ID)
+	}
+
+	if players := racePlayerIDs(t, "sharedrace"); players[0] != account.ID || players[1] != guest.ID {
+		t.Fatalf("shared race players = %v, want [%d %d]", players, account.ID, guest.ID)
+	}
+}
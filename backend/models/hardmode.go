@@ -0,0 +1,92 @@
+// Hard Mode Rules - Revealed hints must be reused
+//
+// BUSINESS RULES (standard Wordle hard mode):
+// - A letter marked "correct" must stay in the same position in every later guess
+// - A letter marked "incorrect-position" must appear somewhere in every later guess
+// - Duplicate hints count: two revealed E's require at least two E's
+//
+// DESIGN DECISION: Constraints are derived from the stored Tries history, which
+// already holds ValidateGuess output, so no extra hint state is persisted
+// Multi-board games check the tries of each unsolved board instead
+package models
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// HardModeViolation names the first revealed hint a guess failed to reuse
+// Rule can be: "correct-position" or "must-contain"
+type HardModeViolation struct {
+	Rule     string `json:"rule"`
+	Letter   string `json:"letter"`
+	Position int    `json:"position,omitempty"` // 1-based, only for "correct-position"
+	Count    int    `json:"count,omitempty"`    // Required occurrences, only for "must-contain"
+}
+
+func (v *HardModeViolation) Error() string {
+	if v.Rule == "correct-position" {
+		return fmt.Sprintf("letter %d must be %s", v.Position, v.Letter)
+	}
+	if v.Count > 1 {
+		return fmt.Sprintf("guess must contain %s at least %d times", v.Letter, v.Count)
+	}
+	return fmt.Sprintf("guess must contain %s", v.Letter)
+}
+
+// CheckHardMode validates a guess against every hint revealed so far
+// Returns nil for games without hard mode or when all constraints are met
+func (gs *GameState) CheckHardMode(guessWord string) error {
+	if !gs.HardMode {
+		return nil
+	}
+	if !gs.IsMultiBoard() {
+		return checkHints(gs.Tries, guessWord)
+	}
+	
+	for _, board := range gs.Boards {
+		if board.Status != StatusPlaying {
+			continue
+		}
+		if err := checkHints(board.Tries, guessWord); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHints validates a guess against the hints revealed by earlier tries
+func checkHints(tries []GuessResult, guessWord string) error {
+	guessLetters := []rune(strings.ToUpper(guessWord))
+	guessLetterCounts := make(map[rune]int)
+	for _, letter := range guessLetters {
+		guessLetterCounts[letter]++
+	}
+	
+	for _, try := range tries {
+		// Green letters are checked first so the most specific hint is reported
+		requiredCounts := make(map[rune]int)
+		for i, letterResult := range try.LetterResultArray {
+			letter, _ := utf8.DecodeRuneInString(letterResult.Letter)
+			switch letterResult.Status {
+			case "correct":
+				if i >= len(guessLetters) || guessLetters[i] != letter {
+					return &HardModeViolation{Rule: "correct-position", Letter: letterResult.Letter, Position: i + 1}
+				}
+				requiredCounts[letter]++
+			case "incorrect-position":
+				requiredCounts[letter]++
+			}
+		}
+		
+		for _, letterResult := range try.LetterResultArray {
+			letter, _ := utf8.DecodeRuneInString(letterResult.Letter)
+			if guessLetterCounts[letter] < requiredCounts[letter] {
+				return &HardModeViolation{Rule: "must-contain", Letter: letterResult.Letter, Count: requiredCounts[letter]}
+			}
+		}
+	}
+	
+	return nil
+}
@@ -0,0 +1,204 @@
+// Leaderboards - Player rankings over finished games
+//
+// ARCHITECTURE DECISION: Rankings aggregated in SQL with GROUP BY user_id
+// - Filters are pushed into the WHERE clause so only matching rows are scanned
+// - Offset pagination; rankings are recomputed per request so ranks stay consistent
+//
+// BUSINESS RULES:
+// - Only finished games by registered players count; guests appear once they claim their games
+// - Games on a seed the player chose do not count, since their words could be worked out beforehand
+// - Challenge games never count, since their creator picked the word; the default
+//   leaderboard ranks the random-word modes only, daily puzzles have to be asked for
+// - Multi-board games never count: their tries and solve times are not comparable
+//   with single-word games
+// - Periods use UTC calendar boundaries: "daily" is today, "weekly" starts on Monday
+// - Solve time is the span from game creation to the winning guess; the solveTime
+//   ranking averages it per win and needs MinSolveTimeWins wins, so one lucky fast
+//   game cannot top it
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"wordle-backend/database"
+)
+
+// LeaderboardFilter selects and orders a leaderboard page
+// Period can be: "daily", "weekly", "all"
+// Mode can be: "speed", "absurdle", "daily", or empty for defaultLeaderboardModes
+// SortBy can be: "wins", "avgGuesses", "solveTime" (speed mode only)
+type LeaderboardFilter struct {
+	Period   string
+	Mode     string
+	WordSize int
+	MaxTries int
+	SortBy   string
+	Page     int
+	PageSize int
+}
+
+// LeaderboardEntry is one ranked player
+type LeaderboardEntry struct {
+	Rank                int     `json:"rank"`
+	UserID              int64   `json:"userId"`
+	Username            string  `json:"username"`
+	GamesPlayed         int     `json:"gamesPlayed"`
+	Wins                int     `json:"wins"`
+	AverageGuesses      float64 `json:"averageGuesses"`
+	TotalSolveSeconds   float64 `json:"totalSolveSeconds"`
+	AverageSolveSeconds float64 `json:"averageSolveSeconds"`
+}
+
+// MinSolveTimeWins is the number of wins a player needs to be ranked by solve time
+const MinSolveTimeWins = 5
+
+// leaderboardModes are the modes a leaderboard can be filtered on
+var leaderboardModes = []string{"speed", "absurdle", "daily"}
+
+// defaultLeaderboardModes are ranked when no mode is given: the words are random per game
+var defaultLeaderboardModes = []string{"speed", "absurdle"}
+
+var leaderboardOrders = map[string]string{
+	"wins":       "wins DESC, average_guesses ASC",
+	"avgGuesses": "average_guesses ASC, wins DESC",
+	"solveTime":  "average_solve_seconds ASC, wins DESC",
+}
+
+// Validate checks filter values and fills in defaults
+func (f *LeaderboardFilter) Validate() error {
+	if f.Period == "" {
+		f.Period = "all"
+	}
+	if f.Period != "daily" && f.Period != "weekly" && f.Period != "all" {
+		return fmt.Errorf("period must be daily, weekly or all, got %q", f.Period)
+	}
+	if f.Mode != "" && !slices.Contains(leaderboardModes, f.Mode) {
+		return fmt.Errorf("mode must be speed, absurdle or daily, got %q", f.Mode)
+	}
+	if f.SortBy == "" {
+		f.SortBy = "wins"
+	}
+	if _, ok := leaderboardOrders[f.SortBy]; !ok {
+		return fmt.Errorf("sort must be wins, avgGuesses or solveTime, got %q", f.SortBy)
+	}
+	if f.SortBy == "solveTime" && f.Mode != "speed" {
+		return fmt.Errorf("sort by solveTime requires mode=speed")
+	}
+	if f.Page == 0 {
+		f.Page = 1
+	}
+	if f.Page < 1 {
+		return fmt.Errorf("page must be at least 1, got %d", f.Page)
+	}
+	if f.PageSize == 0 {
+		f.PageSize = 20
+	}
+	if f.PageSize < 1 || f.PageSize > 100 {
+		return fmt.Errorf("pageSize must be between 1 and 100, got %d", f.PageSize)
+	}
+	return nil
+}
+
+// periodStart returns the earliest created_at included in the period, zero for all-time
+func (f *LeaderboardFilter) periodStart(now time.Time) time.Time {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch f.Period {
+	case "daily":
+		return today
+	case "weekly":
+		daysSinceMonday := (int(today.Weekday()) + 6) % 7
+		return today.AddDate(0, 0, -daysSinceMonday)
+	default:
+		return time.Time{}
+	}
+}
+
+// GetLeaderboard returns one page of ranked players and the total number of ranked players
+// The filter must have been validated; overdue games are timed out first
+func GetLeaderboard(filter LeaderboardFilter) ([]LeaderboardEntry, int, error) {
+	if err := TimeoutOverdueGames(time.Now()); err != nil {
+		return []LeaderboardEntry{}, 0, err
+	}
+	conditions := []string{"g.game_status != 'playing'", "u.is_guest = FALSE", "g.seeded = FALSE", "g.boards IS NULL"}
+	args := []any{}
+	
+	if filter.Mode != "" {
+		conditions = append(conditions, "g.mode = ?")
+		args = append(args, filter.Mode)
+	} else {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(defaultLeaderboardModes)), ", ")
+		conditions = append(conditions, "g.mode IN ("+placeholders+")")
+		for _, mode := range defaultLeaderboardModes {
+			args = append(args, mode)
+		}
+	}
+	if filter.WordSize != 0 {
+		conditions = append(conditions, "g.word_size = ?")
+		args = append(args, filter.WordSize)
+	}
+	if filter.MaxTries != 0 {
+		conditions = append(conditions, "g.max_tries = ?")
+		args = append(args, filter.MaxTries)
+	}
+	if start := filter.periodStart(time.Now()); !start.IsZero() {
+		conditions = append(conditions, database.Driver.Timestamp("g.created_at")+" >= "+database.Driver.Timestamp("?"))
+		args = append(args, start)
+	}
+	
+	having := ""
+	switch filter.SortBy {
+	case "avgGuesses":
+		// Averages are meaningless without at least one win
+		having = "HAVING SUM(CASE WHEN g.game_status = 'won' THEN 1 ELSE 0 END) > 0"
+	case "solveTime":
+		having = "HAVING SUM(CASE WHEN g.game_status = 'won' THEN 1 ELSE 0 END) >= ?"
+	}
+	
+	rankedQuery := `
+		SELECT g.user_id, u.username,
+			COUNT(*) AS games_played,
+			SUM(CASE WHEN g.game_status = 'won' THEN 1 ELSE 0 END) AS wins,
+			COALESCE(AVG(CASE WHEN g.game_status = 'won' THEN ` + database.Driver.JSONArrayLength("g.tries") + ` END), 0) AS average_guesses,
+			COALESCE(SUM(CASE WHEN g.game_status = 'won' THEN ` + database.Driver.SecondsBetween("g.created_at", "g.updated_at") + ` END), 0) AS total_solve_seconds,
+			COALESCE(AVG(CASE WHEN g.game_status = 'won' THEN ` + database.Driver.SecondsBetween("g.created_at", "g.updated_at") + ` END), 0) AS average_solve_seconds
+		FROM game_states g
+		JOIN users u ON u.id = g.user_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		GROUP BY g.user_id, u.username
+		` + having
+	if filter.SortBy == "solveTime" {
+		args = append(args, MinSolveTimeWins)
+	}
+	
+	var total int
+	if err := database.DB.QueryRow(database.Driver.Rebind(`SELECT COUNT(*) FROM (`+rankedQuery+`) AS ranked`), args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count leaderboard: %v", err)
+	}
+	
+	offset := (filter.Page - 1) * filter.PageSize
+	pageQuery := rankedQuery + `
+		ORDER BY ` + leaderboardOrders[filter.SortBy] + `, g.user_id
+		LIMIT ? OFFSET ?
+	`
+	
+	rows, err := database.DB.Query(database.Driver.Rebind(pageQuery), append(args, filter.PageSize, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get leaderboard: %v", err)
+	}
+	defer rows.Close()
+	
+	entries := []LeaderboardEntry{}
+	for rows.Next() {
+		entry := LeaderboardEntry{Rank: offset + len(entries) + 1}
+		err := rows.Scan(&entry.UserID, &entry.Username, &entry.GamesPlayed, &entry.Wins, &entry.AverageGuesses, &entry.TotalSolveSeconds, &entry.AverageSolveSeconds)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan leaderboard entry: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	
+	return entries, total, rows.Err()
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// saveWonGames saves wins speed games for userID, each solved in solveTime
+func saveWonGames(t *testing.T, userID int64, wins int, solveTime time.Duration, change func(gameState *GameState)) {
+	t.Helper()
+	for range wins {
+		saveTestGame(t, func(gameState *GameState) {
+			gameState.UserID = userID
+			gameState.GameStatus = StatusWon
+			gameState.Tries = []GuessResult{{GuessWord: "CRANE", IsCorrect: true}}
+			gameState.UpdatedAt = gameState.CreatedAt.Add(solveTime)
+			if change != nil {
+				change(gameState)
+			}
+		})
+	}
+}
+
+func TestLeaderboardRanksSolveTimePerWin(t *testing.T) {
+	openTestDB(t)
+
+	register := func(username string) int64 {
+		user, err := RegisterUser(username, "correct horse")
+		if err != nil {
+			t.Fatalf("RegisterUser(%s): %v", username, err)
+		}
+		return user.ID
+	}
+	steady := register("steady")
+	prolific := register("prolific")
+	lucky := register("lucky")
+	quordle := register("quordle")
+
+	// More wins add up to more total seconds, but prolific is slower per win
+	saveWonGames(t, steady, MinSolveTimeWins, 30*time.Second, nil)
+	saveWonGames(t, prolific, 2*MinSolveTimeWins, 40*time.Second, nil)
+	saveWonGames(t, lucky, MinSolveTimeWins-1, 5*time.Second, nil)
+	saveWonGames(t, quordle, MinSolveTimeWins, 10*time.Second, func(gameState *GameState) {
+		gameState.Boards = newBoards([]string{"CRANE", "MOODY"})
+	})
+
+	filter := LeaderboardFilter{Mode: "speed", SortBy: "solveTime"}
+	if err := filter.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	entries, total, err := GetLeaderboard(filter)
+	if err != nil {
+		t.Fatalf("GetLeaderboard: %v", err)
+	}
+
+	if total != 2 || len(entries) != 2 || entries[0].UserID != steady || entries[1].UserID != prolific {
+		t.Fatalf("solveTime leaderboard = %+v (total %d), want steady then prolific", entries, total)
+	}
+	if seconds := entries[0].AverageSolveSeconds; seconds < 29 || seconds > 31 {
+		t.Fatalf("steady averages %.1f seconds per win, want 30", seconds)
+	}
+}
@@ -0,0 +1,691 @@
+// Race Models - Multiplayer races to solve the same hidden word
+//
+// ARCHITECTURE DECISION: Races live in their own tables, not in game_states
+// - races holds the room: its code, settings, hidden word, status and winner
+// - race_players holds who is in the room and how far each player got
+// - race_guesses holds every guess with its feedback pattern, so a finished race can be replayed
+// - Races are shared by a random code (96 bits) like challenges, so rooms cannot be enumerated
+// - Live updates are pushed by the routes package (see routes/racehub.go); this file is
+//   the source of truth and never depends on a connection being open
+//
+// BUSINESS RULES:
+// - 2 to 8 players per race; the host creates the room and starts it once a second player joined
+// - Players can join only while the race is waiting, never once it started
+// - The first correct guess wins and finishes the race; everyone still playing loses
+// - A player out of tries loses; once nobody is left playing the race finishes without a winner
+// - A player who leaves a running race loses as well (see routes/races.go for when that is)
+// - RaceTimeLimit after the start the race finishes without a winner and everyone still playing loses
+// - Opponents see each other's feedback patterns, never the letters, until the race is finished
+//
+// CONCURRENCY:
+// - Every write begins by touching the races row, which locks it on PostgreSQL;
+//   SQLite transactions are IMMEDIATE already (see database.InitDB)
+// - So joins cannot overfill a room, and two correct guesses cannot both win
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"wordle-backend/data"
+	"wordle-backend/database"
+	"wordle-backend/helpers"
+)
+
+const (
+	RaceWaiting  = "waiting"
+	RaceRacing   = "racing"
+	RaceFinished = "finished"
+)
+
+// MinRacePlayers and MaxRacePlayers bound the players of one race
+const (
+	MinRacePlayers = 2
+	MaxRacePlayers = 8
+)
+
+// RaceTimeLimit is how long a race may run before it finishes without a winner
+const RaceTimeLimit = 10 * time.Minute
+
+// Race is a room of players guessing the same word
+// Status can be: "waiting" (players may join), "racing" or "finished"
+type Race struct {
+	ID         int64        `json:"-"`
+	Code       string       `json:"code"`
+	HostID     int64        `json:"hostId"`
+	TargetWord string       `json:"-"` // Hidden from client until the race is finished, see routes.RaceResponse
+	Language   string       `json:"language"`
+	WordSize   int          `json:"wordSize"`
+	MaxTries   int          `json:"maxTries"`
+	MaxPlayers int          `json:"maxPlayers"`
+	Status     string       `json:"status"`
+	WinnerID   int64        `json:"winnerId"` // 0 until someone solves the word
+	CreatedAt  time.Time    `json:"createdAt"`
+	StartedAt  time.Time    `json:"startedAt"`
+	FinishedAt time.Time    `json:"finishedAt"`
+	Players    []RacePlayer `json:"players"` // In joining order, host first
+}
+
+// RacePlayer is one player of a race
+// Status can be: "playing", "won" or "lost"
+type RacePlayer struct {
+	UserID     int64     `json:"userId"`
+	Username   string    `json:"username"`
+	Status     string    `json:"status"`
+	Guesses    int       `json:"guesses"`
+	JoinedAt   time.Time `json:"joinedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// RaceGuess is one guess of a race player, as stored in race_guesses
+// Pattern encodes the feedback like GuessResult.Pattern, e.g. "CAPAC"
+type RaceGuess struct {
+	UserID      int64     `json:"userId"`
+	GuessIndex  int       `json:"guessIndex"`
+	GuessWord   string    `json:"guessWord,omitempty"` // Hidden from opponents until the race is finished
+	Pattern     string    `json:"pattern"`
+	IsCorrect   bool      `json:"isCorrect"`
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+// RaceGuessResult is the outcome of a guess played with PlayRaceGuess
+type RaceGuessResult struct {
+	Guess        GuessResult
+	GuessIndex   int
+	PlayerStatus string // Status of the guessing player after the guess
+	RaceStatus   string // Status of the race after the guess
+	WinnerID     int64
+}
+
+var (
+	ErrRaceNotFound       = errors.New("race not found")
+	ErrRaceFull           = errors.New("race is full")
+	ErrRaceAlreadyStarted = errors.New("race has already started")
+	ErrRaceNotStarted     = errors.New("race has not started yet")
+	ErrRaceFinished       = errors.New("race is finished")
+	ErrRaceTimeUp         = errors.New("race time is up")
+	ErrNotRaceHost        = errors.New("only the race host can start the race")
+	ErrNotRacePlayer      = errors.New("not a player of this race")
+	ErrRaceNeedsPlayers   = fmt.Errorf("a race needs at least %d players", MinRacePlayers)
+)
+
+// RaceOptions collects what the host chooses when creating a race
+type RaceOptions struct {
+	Language   string // Defaults to data.DefaultLanguage
+	WordSize   int
+	MaxTries   int
+	MaxPlayers int
+	HostID     int64
+}
+
+// CreateRace validates the race settings and picks its word, without saving anything
+// Game Rule: Race words are drawn from the answer list like speed games, but are never seeded
+func CreateRace(options RaceOptions) (Race, error) {
+	if options.Language == "" {
+		options.Language = data.DefaultLanguage
+	}
+	if err := data.CheckWordSize(options.Language, options.WordSize); err != nil {
+		return Race{}, err
+	}
+	minTries, maxTries := MaxTriesRange(options.WordSize)
+	if options.MaxTries < minTries || options.MaxTries > maxTries {
+		return Race{}, fmt.Errorf("maxTries must be between %d and %d for %d-letter words, got %d", minTries, maxTries, options.WordSize, options.MaxTries)
+	}
+	if options.MaxPlayers < MinRacePlayers || options.MaxPlayers > MaxRacePlayers {
+		return Race{}, fmt.Errorf("maxPlayers must be between %d and %d, got %d", MinRacePlayers, MaxRacePlayers, options.MaxPlayers)
+	}
+
+	targetWord, err := helpers.GetRandomWord(helpers.NewRNG(helpers.NewSeed()), options.Language, options.WordSize)
+	if err != nil {
+		return Race{}, err
+	}
+
+	code, err := randomHex(12)
+	if err != nil {
+		return Race{}, fmt.Errorf("failed to generate race code: %v", err)
+	}
+
+	return Race{
+		Code:       code,
+		HostID:     options.HostID,
+		TargetWord: targetWord,
+		Language:   options.Language,
+		WordSize:   options.WordSize,
+		MaxTries:   options.MaxTries,
+		MaxPlayers: options.MaxPlayers,
+		Status:     RaceWaiting,
+		CreatedAt:  time.Now(),
+		Players:    []RacePlayer{},
+	}, nil
+}
+
+// SaveRace inserts a new race with its host as the first player and sets race.ID
+func SaveRace(race *Race) error {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin saving race: %v", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO races (code, host_id, target_word, language, word_size, max_tries, max_players, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`
+
+	var raceID int64
+	err = tx.QueryRow(database.Driver.Rebind(query), race.Code, race.HostID, race.TargetWord, race.Language, race.WordSize, race.MaxTries, race.MaxPlayers, race.Status, race.CreatedAt).Scan(&raceID)
+	if err != nil {
+		return fmt.Errorf("failed to save race: %v", err)
+	}
+
+	if err := insertRacePlayer(tx, raceID, race.HostID, race.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit race: %v", err)
+	}
+
+	race.ID = raceID
+	return nil
+}
+
+// GetRaceByCode loads a race and its players, returning ErrRaceNotFound for unknown codes
+func GetRaceByCode(code string) (Race, error) {
+	var race Race
+	var winnerID sql.NullInt64
+	var startedAt, finishedAt sql.NullTime
+	query := `
+		SELECT id, code, host_id, target_word, language, word_size, max_tries, max_players, status, winner_id, created_at, started_at, finished_at
+		FROM races
+		WHERE code = ?
+	`
+
+	err := database.DB.QueryRow(database.Driver.Rebind(query), code).Scan(&race.ID, &race.Code, &race.HostID, &race.TargetWord, &race.Language,
+		&race.WordSize, &race.MaxTries, &race.MaxPlayers, &race.Status, &winnerID, &race.CreatedAt, &startedAt, &finishedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Race{}, ErrRaceNotFound
+	}
+	if err != nil {
+		return Race{}, fmt.Errorf("failed to load race: %v", err)
+	}
+	race.WinnerID = winnerID.Int64
+	race.StartedAt = startedAt.Time
+	race.FinishedAt = finishedAt.Time
+
+	race.Players, err = getRacePlayers(race.ID)
+	if err != nil {
+		return Race{}, err
+	}
+
+	return race, nil
+}
+
+// getRacePlayers lists the players of a race in joining order, with their guess counts
+func getRacePlayers(raceID int64) ([]RacePlayer, error) {
+	players := []RacePlayer{}
+	query := `
+		SELECT p.user_id, u.username, p.status, p.joined_at, p.finished_at,
+			(SELECT COUNT(*) FROM race_guesses g WHERE g.race_id = p.race_id AND g.user_id = p.user_id)
+		FROM race_players p
+		JOIN users u ON u.id = p.user_id
+		WHERE p.race_id = ?
+		ORDER BY p.joined_at, p.user_id
+	`
+
+	rows, err := database.DB.Query(database.Driver.Rebind(query), raceID)
+	if err != nil {
+		return players, fmt.Errorf("failed to get race players: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var player RacePlayer
+		var finishedAt sql.NullTime
+		if err := rows.Scan(&player.UserID, &player.Username, &player.Status, &player.JoinedAt, &finishedAt, &player.Guesses); err != nil {
+			return players, fmt.Errorf("failed to scan race player: %v", err)
+		}
+		player.FinishedAt = finishedAt.Time
+		players = append(players, player)
+	}
+
+	return players, rows.Err()
+}
+
+// GetRaceGuesses lists every guess of a race in the order they were played
+func GetRaceGuesses(raceID int64) ([]RaceGuess, error) {
+	guesses := []RaceGuess{}
+	query := `
+		SELECT user_id, guess_index, word, results, is_correct, submitted_at
+		FROM race_guesses
+		WHERE race_id = ?
+		ORDER BY submitted_at, user_id, guess_index
+	`
+
+	rows, err := database.DB.Query(database.Driver.Rebind(query), raceID)
+	if err != nil {
+		return guesses, fmt.Errorf("failed to get race guesses: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var guess RaceGuess
+		if err := rows.Scan(&guess.UserID, &guess.GuessIndex, &guess.GuessWord, &guess.Pattern, &guess.IsCorrect, &guess.SubmittedAt); err != nil {
+			return guesses, fmt.Errorf("failed to scan race guess: %v", err)
+		}
+		guesses = append(guesses, guess)
+	}
+
+	return guesses, rows.Err()
+}
+
+// HasPlayer reports whether a user is a player of the race
+func (r *Race) HasPlayer(userID int64) bool {
+	for _, player := range r.Players {
+		if player.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Deadline is when a started race runs out of time; zero while it is waiting
+func (r *Race) Deadline() time.Time {
+	if r.StartedAt.IsZero() {
+		return time.Time{}
+	}
+	return r.StartedAt.Add(RaceTimeLimit)
+}
+
+// NormalizeGuess spells a guess the way the race's word lists do, see GameState.NormalizeGuess
+func (r *Race) NormalizeGuess(guessWord string) string {
+	language, err := data.GetLanguage(r.Language)
+	if err != nil {
+		return strings.ToUpper(strings.TrimSpace(guessWord))
+	}
+	return language.Normalize(guessWord)
+}
+
+// CheckGuessLength rejects a normalized guess whose letter count differs from the race's word size
+func (r *Race) CheckGuessLength(guessWord string) error {
+	if length := utf8.RuneCountInString(guessWord); length != r.WordSize {
+		return &GuessLengthError{WordSize: r.WordSize, Length: length}
+	}
+	return nil
+}
+
+// JoinRace adds a player to a waiting race
+// Joining a race you are already in is a no-op, so clients can safely retry
+func JoinRace(code string, userID int64) (Race, error) {
+	race, err := GetRaceByCode(code)
+	if err != nil {
+		return Race{}, err
+	}
+
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return Race{}, fmt.Errorf("failed to begin joining race: %v", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRace(tx, race.ID)
+	if err != nil {
+		return Race{}, err
+	}
+
+	var joined, players int
+	query := `
+		SELECT COALESCE(SUM(CASE WHEN user_id = ? THEN 1 ELSE 0 END), 0), COUNT(*)
+		FROM race_players
+		WHERE race_id = ?
+	`
+	if err := tx.QueryRow(database.Driver.Rebind(query), userID, race.ID).Scan(&joined, &players); err != nil {
+		return Race{}, fmt.Errorf("failed to count race players: %v", err)
+	}
+	if joined > 0 {
+		return GetRaceByCode(code)
+	}
+	if status != RaceWaiting {
+		return Race{}, ErrRaceAlreadyStarted
+	}
+	if players >= race.MaxPlayers {
+		return Race{}, ErrRaceFull
+	}
+
+	if err := insertRacePlayer(tx, race.ID, userID, time.Now()); err != nil {
+		return Race{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Race{}, fmt.Errorf("failed to commit joining race: %v", err)
+	}
+
+	return GetRaceByCode(code)
+}
+
+// StartRace lets the host start a waiting race once enough players joined
+func StartRace(code string, userID int64) (Race, error) {
+	race, err := GetRaceByCode(code)
+	if err != nil {
+		return Race{}, err
+	}
+	if race.HostID != userID {
+		return Race{}, ErrNotRaceHost
+	}
+
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return Race{}, fmt.Errorf("failed to begin starting race: %v", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRace(tx, race.ID)
+	if err != nil {
+		return Race{}, err
+	}
+	if status != RaceWaiting {
+		return Race{}, ErrRaceAlreadyStarted
+	}
+
+	var players int
+	if err := tx.QueryRow(database.Driver.Rebind(`SELECT COUNT(*) FROM race_players WHERE race_id = ?`), race.ID).Scan(&players); err != nil {
+		return Race{}, fmt.Errorf("failed to count race players: %v", err)
+	}
+	if players < MinRacePlayers {
+		return Race{}, ErrRaceNeedsPlayers
+	}
+
+	query := `
+		UPDATE races
+		SET status = ?, started_at = ?
+		WHERE id = ?
+	`
+	if _, err := tx.Exec(database.Driver.Rebind(query), RaceRacing, time.Now(), race.ID); err != nil {
+		return Race{}, fmt.Errorf("failed to start race: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Race{}, fmt.Errorf("failed to commit starting race: %v", err)
+	}
+
+	return GetRaceByCode(code)
+}
+
+// PlayRaceGuess scores a normalized guess against the race's word and records it
+// Word list validation is left to the caller, as for PlayGuess
+// Game Rule: The first correct guess to commit wins; a second correct guess racing
+// it waits for the lock and then finds the race finished
+func PlayRaceGuess(raceID int64, userID int64, guessWord string, now time.Time) (RaceGuessResult, error) {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return RaceGuessResult{}, fmt.Errorf("failed to begin race guess: %v", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRace(tx, raceID)
+	if err != nil {
+		return RaceGuessResult{}, err
+	}
+	switch status {
+	case RaceWaiting:
+		return RaceGuessResult{}, ErrRaceNotStarted
+	case RaceFinished:
+		return RaceGuessResult{}, ErrRaceFinished
+	}
+
+	var targetWord string
+	var wordSize, maxTries int
+	var startedAt time.Time
+	raceQuery := `SELECT target_word, word_size, max_tries, started_at FROM races WHERE id = ?`
+	if err := tx.QueryRow(database.Driver.Rebind(raceQuery), raceID).Scan(&targetWord, &wordSize, &maxTries, &startedAt); err != nil {
+		return RaceGuessResult{}, fmt.Errorf("failed to load race: %v", err)
+	}
+	// The race is finished by ExpireRace; a guess must not slip in before that runs
+	if now.After(startedAt.Add(RaceTimeLimit)) {
+		return RaceGuessResult{}, ErrRaceTimeUp
+	}
+
+	var playerStatus string
+	var guessIndex int
+	playerQuery := `
+		SELECT p.status, (SELECT COUNT(*) FROM race_guesses g WHERE g.race_id = p.race_id AND g.user_id = p.user_id)
+		FROM race_players p
+		WHERE p.race_id = ? AND p.user_id = ?
+	`
+	err = tx.QueryRow(database.Driver.Rebind(playerQuery), raceID, userID).Scan(&playerStatus, &guessIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return RaceGuessResult{}, ErrNotRacePlayer
+	}
+	if err != nil {
+		return RaceGuessResult{}, fmt.Errorf("failed to load race player: %v", err)
+	}
+	if playerStatus != StatusPlaying {
+		return RaceGuessResult{}, &GameOverError{Status: playerStatus}
+	}
+	if length := utf8.RuneCountInString(guessWord); length != wordSize {
+		return RaceGuessResult{}, &GuessLengthError{WordSize: wordSize, Length: length}
+	}
+
+	guessResult := GuessResult{
+		GuessWord:         guessWord,
+		LetterResultArray: ValidateGuess(guessWord, targetWord),
+		IsCorrect:         guessWord == targetWord,
+	}
+	guessQuery := `
+		INSERT INTO race_guesses (race_id, user_id, guess_index, word, results, is_correct, submitted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	if _, err := tx.Exec(database.Driver.Rebind(guessQuery), raceID, userID, guessIndex, guessWord, guessResult.Pattern(), guessResult.IsCorrect, now); err != nil {
+		return RaceGuessResult{}, fmt.Errorf("failed to record race guess: %v", err)
+	}
+
+	result := RaceGuessResult{Guess: guessResult, GuessIndex: guessIndex, PlayerStatus: StatusPlaying, RaceStatus: RaceRacing}
+	switch {
+	case guessResult.IsCorrect:
+		result.PlayerStatus = StatusWon
+		result.WinnerID = userID
+	case guessIndex+1 >= maxTries:
+		result.PlayerStatus = StatusLost
+	}
+
+	if result.PlayerStatus != StatusPlaying {
+		if err := finishRacePlayer(tx, raceID, userID, result.PlayerStatus, now); err != nil {
+			return RaceGuessResult{}, err
+		}
+	}
+
+	stillPlaying, err := countPlayingRacePlayers(tx, raceID)
+	if err != nil {
+		return RaceGuessResult{}, err
+	}
+	if result.WinnerID != 0 || stillPlaying == 0 {
+		if err := finishRace(tx, raceID, result.WinnerID, now); err != nil {
+			return RaceGuessResult{}, err
+		}
+		result.RaceStatus = RaceFinished
+	}
+
+	if err := tx.Commit(); err != nil {
+		return RaceGuessResult{}, fmt.Errorf("failed to commit race guess: %v", err)
+	}
+
+	return result, nil
+}
+
+// ForfeitRace makes a player who left a running race lose, and finishes the race
+// without a winner once nobody is left playing
+// Returns whether the player was still playing, and the race's status afterwards
+func ForfeitRace(raceID int64, userID int64, now time.Time) (bool, string, error) {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return false, "", fmt.Errorf("failed to begin forfeiting race: %v", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRace(tx, raceID)
+	if err != nil {
+		return false, "", err
+	}
+	if status != RaceRacing {
+		return false, status, nil
+	}
+
+	query := `
+		UPDATE race_players
+		SET status = ?, finished_at = ?
+		WHERE race_id = ? AND user_id = ? AND status = ?
+	`
+	result, err := tx.Exec(database.Driver.Rebind(query), StatusLost, now, raceID, userID, StatusPlaying)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to forfeit race player: %v", err)
+	}
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return false, "", fmt.Errorf("failed to check race player update: %v", err)
+	}
+	if updatedRows == 0 {
+		return false, status, nil
+	}
+
+	stillPlaying, err := countPlayingRacePlayers(tx, raceID)
+	if err != nil {
+		return false, "", err
+	}
+	if stillPlaying == 0 {
+		if err := finishRace(tx, raceID, 0, now); err != nil {
+			return false, "", err
+		}
+		status = RaceFinished
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, "", fmt.Errorf("failed to commit forfeiting race: %v", err)
+	}
+
+	return true, status, nil
+}
+
+// ExpireRace finishes a running race without a winner once its RaceTimeLimit is up
+// Returns false, changing nothing, if the race is not running or has time left
+func ExpireRace(raceID int64, now time.Time) (bool, error) {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin expiring race: %v", err)
+	}
+	defer tx.Rollback()
+
+	status, err := lockRace(tx, raceID)
+	if err != nil {
+		return false, err
+	}
+	if status != RaceRacing {
+		return false, nil
+	}
+
+	var startedAt time.Time
+	if err := tx.QueryRow(database.Driver.Rebind(`SELECT started_at FROM races WHERE id = ?`), raceID).Scan(&startedAt); err != nil {
+		return false, fmt.Errorf("failed to load race: %v", err)
+	}
+	if !now.After(startedAt.Add(RaceTimeLimit)) {
+		return false, nil
+	}
+
+	if err := finishRace(tx, raceID, 0, now); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit expiring race: %v", err)
+	}
+
+	return true, nil
+}
+
+// lockRace reads a race's status inside a write transaction
+// The no-op UPDATE takes the row lock on PostgreSQL, serializing writers of the same race
+func lockRace(tx *sql.Tx, raceID int64) (string, error) {
+	var status string
+	query := `
+		UPDATE races
+		SET status = status
+		WHERE id = ?
+		RETURNING status
+	`
+
+	err := tx.QueryRow(database.Driver.Rebind(query), raceID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrRaceNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to lock race: %v", err)
+	}
+
+	return status, nil
+}
+
+// countPlayingRacePlayers counts the players of a race who have not finished yet
+func countPlayingRacePlayers(tx *sql.Tx, raceID int64) (int, error) {
+	var stillPlaying int
+	query := `SELECT COUNT(*) FROM race_players WHERE race_id = ? AND status = ?`
+	if err := tx.QueryRow(database.Driver.Rebind(query), raceID, StatusPlaying).Scan(&stillPlaying); err != nil {
+		return 0, fmt.Errorf("failed to count race players: %v", err)
+	}
+	return stillPlaying, nil
+}
+
+// insertRacePlayer adds a playing player to a race
+func insertRacePlayer(tx *sql.Tx, raceID int64, userID int64, joinedAt time.Time) error {
+	query := `
+		INSERT INTO race_players (race_id, user_id, status, joined_at)
+		VALUES (?, ?, ?, ?)
+	`
+
+	if _, err := tx.Exec(database.Driver.Rebind(query), raceID, userID, StatusPlaying, joinedAt); err != nil {
+		return fmt.Errorf("failed to add race player: %v", err)
+	}
+
+	return nil
+}
+
+// finishRacePlayer records that a player won or ran out of tries
+func finishRacePlayer(tx *sql.Tx, raceID int64, userID int64, status string, now time.Time) error {
+	query := `
+		UPDATE race_players
+		SET status = ?, finished_at = ?
+		WHERE race_id = ? AND user_id = ?
+	`
+
+	if _, err := tx.Exec(database.Driver.Rebind(query), status, now, raceID, userID); err != nil {
+		return fmt.Errorf("failed to update race player: %v", err)
+	}
+
+	return nil
+}
+
+// finishRace ends a race, with winnerID 0 when nobody solved the word
+// Players still playing lose, since the race is over for them too
+func finishRace(tx *sql.Tx, raceID int64, winnerID int64, now time.Time) error {
+	raceQuery := `
+		UPDATE races
+		SET status = ?, winner_id = ?, finished_at = ?
+		WHERE id = ?
+	`
+	if _, err := tx.Exec(database.Driver.Rebind(raceQuery), RaceFinished, nullInt64(winnerID), now, raceID); err != nil {
+		return fmt.Errorf("failed to finish race: %v", err)
+	}
+
+	playersQuery := `
+		UPDATE race_players
+		SET status = ?, finished_at = ?
+		WHERE race_id = ? AND status = ?
+	`
+	if _, err := tx.Exec(database.Driver.Rebind(playersQuery), StatusLost, now, raceID, StatusPlaying); err != nil {
+		return fmt.Errorf("failed to finish race players: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// startTestRace saves a two-player CRANE race and starts it, returning the race
+// and its players' IDs, host first
+func startTestRace(t *testing.T) (Race, int64, int64) {
+	t.Helper()
+	openTestDB(t)
+
+	host, _, err := CreateGuestUser()
+	if err != nil {
+		t.Fatalf("CreateGuestUser: %v", err)
+	}
+	opponent, _, err := CreateGuestUser()
+	if err != nil {
+		t.Fatalf("CreateGuestUser: %v", err)
+	}
+
+	race := Race{
+		Code:       "testrace",
+		HostID:     host.ID,
+		TargetWord: "CRANE",
+		Language:   "en",
+		WordSize:   5,
+		MaxTries:   6,
+		MaxPlayers: MaxRacePlayers,
+		Status:     RaceWaiting,
+		CreatedAt:  time.Now(),
+	}
+	if err := SaveRace(&race); err != nil {
+		t.Fatalf("SaveRace: %v", err)
+	}
+	if _, err := JoinRace(race.Code, opponent.ID); err != nil {
+		t.Fatalf("JoinRace: %v", err)
+	}
+	race, err = StartRace(race.Code, host.ID)
+	if err != nil {
+		t.Fatalf("StartRace: %v", err)
+	}
+	return race, host.ID, opponent.ID
+}
+
+func TestForfeitRaceFinishesOnceNobodyIsLeft(t *testing.T) {
+	race, hostID, opponentID := startTestRace(t)
+
+	forfeited, status, err := ForfeitRace(race.ID, hostID, time.Now())
+	if err != nil || !forfeited || status != RaceRacing {
+		t.Fatalf("host forfeit = %v, %s, %v, want true, %s", forfeited, status, err, RaceRacing)
+	}
+	if forfeited, _, err := ForfeitRace(race.ID, hostID, time.Now()); err != nil || forfeited {
+		t.Fatalf("second host forfeit = %v, %v, want false", forfeited, err)
+	}
+
+	forfeited, status, err = ForfeitRace(race.ID, opponentID, time.Now())
+	if err != nil || !forfeited || status != RaceFinished {
+		t.Fatalf("opponent forfeit = %v, %s, %v, want true, %s", forfeited, status, err, RaceFinished)
+	}
+
+	finished, err := GetRaceByCode(race.Code)
+	if err != nil {
+		t.Fatalf("GetRaceByCode: %v", err)
+	}
+	if finished.WinnerID != 0 {
+		t.Fatalf("race won by %d after everyone left", finished.WinnerID)
+	}
+	for _, player := range finished.Players {
+		if player.Status != StatusLost {
+			t.Fatalf("player %d status = %s, want %s", player.UserID, player.Status, StatusLost)
+		}
+	}
+}
+
+func TestExpireRaceOnlyAfterTimeLimit(t *testing.T) {
+	race, hostID, _ := startTestRace(t)
+	late := race.Deadline().Add(time.Second)
+
+	if expired, err := ExpireRace(race.ID, time.Now()); err != nil || expired {
+		t.Fatalf("ExpireRace before the deadline = %v, %v, want false", expired, err)
+	}
+	if _, err := PlayRaceGuess(race.ID, hostID, "CRANE", late); !errors.Is(err, ErrRaceTimeUp) {
+		t.Fatalf("guess after the deadline error = %v, want ErrRaceTimeUp", err)
+	}
+
+	if expired, err := ExpireRace(race.ID, late); err != nil || !expired {
+		t.Fatalf("ExpireRace after the deadline = %v, %v, want true", expired, err)
+	}
+	finished, err := GetRaceByCode(race.Code)
+	if err != nil {
+		t.Fatalf("GetRaceByCode: %v", err)
+	}
+	if finished.Status != RaceFinished || finished.WinnerID != 0 {
+		t.Fatalf("expired race status %s, winner %d", finished.Status, finished.WinnerID)
+	}
+}
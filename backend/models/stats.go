@@ -0,0 +1,126 @@
+// Player Statistics - Aggregates finished games per player
+//
+// ARCHITECTURE DECISION: Stats computed on demand from game_states
+// - Guess counts come from json_array_length(tries) so rows are not unmarshalled
+// - Streaks need chronological order, so rows are folded in Go rather than SQL
+//
+// BUSINESS RULES:
+// - Only finished games count; games still "playing" are ignored
+// - A streak is consecutive wins; a loss or timeout resets the current streak
+package models
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	"wordle-backend/database"
+)
+
+// StatsSummary holds the classic Wordle statistics for a set of games
+// GuessDistribution[i] counts wins solved in i+1 guesses
+type StatsSummary struct {
+	GamesPlayed       int     `json:"gamesPlayed"`
+	GamesWon          int     `json:"gamesWon"`
+	WinPercentage     float64 `json:"winPercentage"`
+	CurrentStreak     int     `json:"currentStreak"`
+	MaxStreak         int     `json:"maxStreak"`
+	GuessDistribution []int   `json:"guessDistribution"`
+}
+
+// StatsBreakdown is the summary for one mode and word size combination
+type StatsBreakdown struct {
+	Mode     string `json:"mode"`
+	WordSize int    `json:"wordSize"`
+	StatsSummary
+}
+
+// PlayerStats is the full statistics payload for a player
+type PlayerStats struct {
+	UserID    int64            `json:"userId"`
+	Overall   StatsSummary     `json:"overall"`
+	Breakdown []StatsBreakdown `json:"breakdown"`
+}
+
+type statsKey struct {
+	mode     string
+	wordSize int
+}
+
+// GetPlayerStats computes overall and per mode/word size statistics for a player
+// Overdue games are timed out first, so abandoned speed games count as losses
+func GetPlayerStats(userID int64) (PlayerStats, error) {
+	stats := PlayerStats{UserID: userID, Breakdown: []StatsBreakdown{}}
+	stats.Overall.GuessDistribution = []int{}
+	if err := TimeoutOverdueGames(time.Now()); err != nil {
+		return stats, err
+	}
+	
+	query := `
+		SELECT mode, word_size, max_tries, game_status, ` + database.Driver.JSONArrayLength("COALESCE(tries, '[]')") + `
+		FROM game_states
+		WHERE user_id = ? AND game_status != 'playing'
+		ORDER BY created_at, id
+	`
+	
+	rows, err := database.DB.Query(database.Driver.Rebind(query), userID)
+	if err != nil {
+		return PlayerStats{}, fmt.Errorf("failed to get player games: %v", err)
+	}
+	defer rows.Close()
+	
+	breakdowns := make(map[statsKey]*StatsSummary)
+	for rows.Next() {
+		var key statsKey
+		var maxTries, guessCount int
+		var gameStatus string
+		if err := rows.Scan(&key.mode, &key.wordSize, &maxTries, &gameStatus, &guessCount); err != nil {
+			return PlayerStats{}, fmt.Errorf("failed to scan player game: %v", err)
+		}
+		
+		if breakdowns[key] == nil {
+			breakdowns[key] = &StatsSummary{GuessDistribution: []int{}}
+		}
+		won := gameStatus == StatusWon
+		stats.Overall.addGame(won, guessCount, maxTries)
+		breakdowns[key].addGame(won, guessCount, maxTries)
+	}
+	if err := rows.Err(); err != nil {
+		return PlayerStats{}, fmt.Errorf("failed to read player games: %v", err)
+	}
+	
+	for key, summary := range breakdowns {
+		stats.Breakdown = append(stats.Breakdown, StatsBreakdown{Mode: key.mode, WordSize: key.wordSize, StatsSummary: *summary})
+	}
+	sort.Slice(stats.Breakdown, func(i, j int) bool {
+		if stats.Breakdown[i].Mode != stats.Breakdown[j].Mode {
+			return stats.Breakdown[i].Mode < stats.Breakdown[j].Mode
+		}
+		return stats.Breakdown[i].WordSize < stats.Breakdown[j].WordSize
+	})
+	
+	return stats, nil
+}
+
+// addGame folds one finished game into the summary; games must arrive oldest first
+func (s *StatsSummary) addGame(won bool, guessCount int, maxTries int) {
+	// Distribution covers 1..MaxTries of the longest game seen
+	for len(s.GuessDistribution) < maxTries {
+		s.GuessDistribution = append(s.GuessDistribution, 0)
+	}
+	
+	s.GamesPlayed++
+	if won {
+		s.GamesWon++
+		s.CurrentStreak++
+		if s.CurrentStreak > s.MaxStreak {
+			s.MaxStreak = s.CurrentStreak
+		}
+		if guessCount >= 1 && guessCount <= len(s.GuessDistribution) {
+			s.GuessDistribution[guessCount-1]++
+		}
+	} else {
+		s.CurrentStreak = 0
+	}
+	
+	s.WinPercentage = float64(s.GamesWon) * 100 / float64(s.GamesPlayed)
+}
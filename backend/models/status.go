@@ -0,0 +1,123 @@
+// Game Status State Machine
+//
+// ARCHITECTURE DECISION: Explicit transition table instead of ad-hoc status checks
+// - "playing" is the only non-terminal status
+// - Every status change goes through TransitionTo, so finished games can never be revived
+// - Domain failures are typed errors; the routes package maps them to HTTP codes
+//
+//	playing ──► won        (correct guess)
+//	        ──► lost       (out of tries)
+//	        ──► timeout    (per-guess deadline missed)
+//	        ──► abandoned  (player left)
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	StatusPlaying   = "playing"
+	StatusWon       = "won"
+	StatusLost      = "lost"
+	StatusTimeout   = "timeout"
+	StatusAbandoned = "abandoned"
+)
+
+// allowedTransitions lists the statuses reachable from each status
+var allowedTransitions = map[string][]string{
+	StatusPlaying: {StatusWon, StatusLost, StatusTimeout, StatusAbandoned},
+}
+
+// ErrGameStateNotFound is returned when no game has the requested ID
+var ErrGameStateNotFound = errors.New("game state not found")
+
+// StatusTransitionError is returned for a status change the state machine does not allow
+type StatusTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("cannot change game status from %s to %s", e.From, e.To)
+}
+
+// GameOverError is returned when a guess is submitted to a game that has already ended
+type GameOverError struct {
+	Status string
+}
+
+func (e *GameOverError) Error() string {
+	return fmt.Sprintf("game is already over (%s)", e.Status)
+}
+
+// GuessLengthError is returned when a guess does not match the game's word size
+type GuessLengthError struct {
+	WordSize int
+	Length   int
+}
+
+func (e *GuessLengthError) Error() string {
+	return fmt.Sprintf("guess must be %d letters, got %d", e.WordSize, e.Length)
+}
+
+// IsFinished reports whether the game has reached a terminal status
+// SECURITY: Only finished games may reveal their target word
+func (gs *GameState) IsFinished() bool {
+	return gs.GameStatus != StatusPlaying
+}
+
+// CanTransitionTo reports whether the state machine allows moving to status
+func (gs *GameState) CanTransitionTo(status string) bool {
+	for _, allowed := range allowedTransitions[gs.GameStatus] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo moves the in-memory game to a new status, enforcing allowed transitions
+func (gs *GameState) TransitionTo(status string) error {
+	if !gs.CanTransitionTo(status) {
+		return &StatusTransitionError{From: gs.GameStatus, To: status}
+	}
+	gs.GameStatus = status
+	return nil
+}
+
+// CheckGuessLength ensures a guess has exactly WordSize letters
+func (gs *GameState) CheckGuessLength(guessWord string) error {
+	length := utf8.RuneCountInString(guessWord)
+	if length != gs.WordSize {
+		return &GuessLengthError{WordSize: gs.WordSize, Length: length}
+	}
+	return nil
+}
+
+// finish persists a terminal status for a game that is still playing
+// Unsolved boards are lost with the game, and an undecided absurdle game commits to a
+// word, as when its tries run out
+// CONCURRENCY: The store only updates a game still at the version gs was read at, so if
+// another request played a guess or ended the game first ErrStaleGameState is returned
+// instead of overwriting its boards or candidates with this stale copy
+func (gs *GameState) finish(status string) error {
+	// Changes are made on a copy, so a failed save leaves gs as it was
+	finished := cloneGameState(*gs)
+	if err := finished.TransitionTo(status); err != nil {
+		return err
+	}
+	finished.loseBoards()
+	finished.commitCandidate()
+	finished.UpdatedAt = time.Now()
+	
+	if err := Games.SetStatus(finished, gs.Version); err != nil {
+		return err
+	}
+	
+	finished.Version++
+	*gs = finished
+	return nil
+}
@@ -0,0 +1,70 @@
+// Game Store - Persistence boundary for game states
+//
+// ARCHITECTURE DECISION: Game persistence behind an interface
+// - Game rules (validation, scoring, status transitions) stay on GameState
+// - A GameStore only loads and saves, so backends can be swapped by configuration
+// - Implementations: SQLite (default), PostgreSQL, and in-memory for tests
+//
+// DESIGN PATTERNS USED:
+// - Repository Pattern: GameStore is the repository for GameState
+// - Strategy Pattern: The backend is chosen once at startup by InitGameStore
+//
+// TRADE-OFFS CONSIDERED:
+// - Read-only aggregates (stats, leaderboards, daily results) query SQL directly,
+//   since pushing GROUP BY through the interface would mean reimplementing SQL in Go
+package models
+
+import (
+	"time"
+	"wordle-backend/database"
+)
+
+// GameStore persists game states
+//
+// CONCURRENCY: Implementations must make AppendGuess and SetStatus atomic
+// compare-and-swap operations, so concurrent requests cannot both win
+type GameStore interface {
+	// CreateGame inserts a new game and sets gameState.ID
+	// Returns ErrDailyAlreadyPlayed for a second game of the same daily puzzle
+	CreateGame(gameState *GameState) error
+
+	// CreateGuestGame is CreateGame for an anonymous player: it creates a guest account,
+	// sets gameState.UserID to it and returns the guest's bearer token
+	// SQL stores do both in one transaction, so a failed insert leaves no guest behind
+	CreateGuestGame(gameState *GameState) (string, error)
+
+	// GetGame loads a game, returning ErrGameStateNotFound if it does not exist
+	GetGame(gameStateID int64) (GameState, error)
+
+	// ListGames returns one page of games matching a validated filter and the
+	// cursor for the next page, 0 when there are no more games
+	ListGames(filter GameStateFilter) ([]GameState, int64, error)
+
+	// ListOverdueGames returns the playing games whose guess deadline is before the given
+	// time, oldest first
+	ListOverdueGames(before time.Time) ([]GameState, error)
+
+	// AppendGuess loads a game, checks it is still at expectedVersion, lets play
+	// record the guess and saves tries, status and deadline with version + 1
+	// SQL stores also add the new guess to the guesses table
+	// Returns ErrStaleGameState if the game changed in the meantime
+	AppendGuess(gameStateID int64, expectedVersion int, play func(gameState *GameState) error) (GameState, error)
+
+	// SetStatus saves gameState's status, target word, boards, candidates and updated_at
+	// and bumps its version, but only if the stored version is still expectedVersion
+	// Returns ErrStaleGameState if the game changed in the meantime
+	SetStatus(gameState GameState, expectedVersion int) error
+}
+
+// Games is the store used by the game functions in this package
+var Games GameStore
+
+// InitGameStore selects the game store matching the configured database
+// Must be called after database.InitDB
+func InitGameStore() {
+	if database.Driver == database.Postgres {
+		Games = NewPostgresGameStore(database.DB)
+		return
+	}
+	Games = NewSQLiteGameStore(database.DB)
+}
@@ -0,0 +1,223 @@
+// In-Memory Game Store - GameStore without a database
+//
+// ARCHITECTURE DECISION: Map of games guarded by one mutex
+// - Intended for tests and experiments that should not touch a database file
+// - Same contract as the SQL store: IDs start at 1, versions, daily and challenge limits are enforced
+//
+// TRADE-OFFS CONSIDERED:
+// - Stats, leaderboards and daily results read game_states through SQL,
+//   so they do not see games held in memory
+// - There is no guesses table to write; tries on each game hold the same data
+// - Guest accounts are users rows, so CreateGuestGame still needs the database
+package models
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+type memoryGameStore struct {
+	mutex  sync.Mutex
+	games  map[int64]GameState
+	nextID int64
+}
+
+// NewMemoryGameStore returns an empty GameStore held in process memory
+func NewMemoryGameStore() GameStore {
+	return &memoryGameStore{games: make(map[int64]GameState), nextID: 1}
+}
+
+func (s *memoryGameStore) CreateGame(gameState *GameState) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if gameState.Mode == "daily" {
+		for _, existing := range s.games {
+			if existing.Mode == "daily" && existing.UserID == gameState.UserID && existing.DailyDate == gameState.DailyDate && existing.Language == gameState.Language && existing.WordSize == gameState.WordSize {
+				return ErrDailyAlreadyPlayed
+			}
+		}
+	}
+	if gameState.ChallengeID != 0 {
+		for _, existing := range s.games {
+			if existing.ChallengeID == gameState.ChallengeID && existing.UserID == gameState.UserID {
+				return ErrChallengeAlreadyPlayed
+			}
+		}
+	}
+
+	gameState.ID = s.nextID
+	s.nextID++
+	s.games[gameState.ID] = cloneGameState(*gameState)
+	return nil
+}
+
+// CreateGuestGame creates the guest in the database first: guests are users rows,
+// which the memory store has no transaction to share with
+func (s *memoryGameStore) CreateGuestGame(gameState *GameState) (string, error) {
+	guest, token, err := CreateGuestUser()
+	if err != nil {
+		return "", err
+	}
+	owned := *gameState
+	owned.UserID = guest.ID
+	if err := s.CreateGame(&owned); err != nil {
+		return "", err
+	}
+	*gameState = owned
+	return token, nil
+}
+
+func (s *memoryGameStore) GetGame(gameStateID int64) (GameState, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	gameState, ok := s.games[gameStateID]
+	if !ok {
+		return GameState{}, ErrGameStateNotFound
+	}
+	return cloneGameState(gameState), nil
+}
+
+func (s *memoryGameStore) ListGames(filter GameStateFilter) ([]GameState, int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	gameStates := []GameState{}
+	for _, gameState := range s.games {
+		if filter.matches(gameState) {
+			gameStates = append(gameStates, cloneGameState(gameState))
+		}
+	}
+
+	sort.Slice(gameStates, func(i, j int) bool {
+		if filter.Order == "asc" {
+			return gameStates[i].ID < gameStates[j].ID
+		}
+		return gameStates[i].ID > gameStates[j].ID
+	})
+	if len(gameStates) > filter.Limit+1 {
+		gameStates = gameStates[:filter.Limit+1]
+	}
+
+	gameStates, nextCursor := trimPage(gameStates, filter.Limit)
+	return gameStates, nextCursor, nil
+}
+
+func (s *memoryGameStore) ListOverdueGames(before time.Time) ([]GameState, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	gameStates := []GameState{}
+	for _, gameState := range s.games {
+		if gameState.GameStatus == StatusPlaying && !gameState.GuessDeadline.IsZero() && gameState.GuessDeadline.Before(before) {
+			gameStates = append(gameStates, cloneGameState(gameState))
+		}
+	}
+
+	sort.Slice(gameStates, func(i, j int) bool {
+		return gameStates[i].ID < gameStates[j].ID
+	})
+	return gameStates, nil
+}
+
+// matches applies the filter conditions the SQL store puts in its WHERE clause
+func (f *GameStateFilter) matches(gameState GameState) bool {
+	if f.GameStatus != "" && gameState.GameStatus != f.GameStatus {
+		return false
+	}
+	if f.Mode != "" && gameState.Mode != f.Mode {
+		return false
+	}
+	if f.WordSize != 0 && gameState.WordSize != f.WordSize {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && gameState.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !gameState.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if f.AfterID != 0 {
+		if f.Order == "asc" && gameState.ID <= f.AfterID {
+			return false
+		}
+		if f.Order != "asc" && gameState.ID >= f.AfterID {
+			return false
+		}
+	}
+	return true
+}
+
+// AppendGuess holds the lock across read-validate-append, which makes it atomic
+func (s *memoryGameStore) AppendGuess(gameStateID int64, expectedVersion int, play func(gameState *GameState) error) (GameState, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.games[gameStateID]
+	if !ok {
+		return GameState{}, ErrGameStateNotFound
+	}
+	if stored.Version != expectedVersion {
+		return GameState{}, ErrStaleGameState
+	}
+
+	// play works on a copy so a rejected guess leaves the stored game untouched
+	gameState := cloneGameState(stored)
+	if err := play(&gameState); err != nil {
+		return GameState{}, err
+	}
+
+	gameState.Version++
+	s.games[gameStateID] = cloneGameState(gameState)
+	return gameState, nil
+}
+
+func (s *memoryGameStore) SetStatus(gameState GameState, expectedVersion int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.games[gameState.ID]
+	if !ok || stored.Version != expectedVersion {
+		return ErrStaleGameState
+	}
+
+	finished := cloneGameState(gameState)
+	stored.TargetWord = finished.TargetWord
+	stored.Boards = finished.Boards
+	stored.Candidates = finished.Candidates
+	stored.GameStatus = finished.GameStatus
+	stored.UpdatedAt = gameState.UpdatedAt
+	stored.Version++
+	s.games[gameState.ID] = stored
+	return nil
+}
+
+// cloneGameState copies the tries, boards and candidates so callers cannot mutate stored games
+func cloneGameState(gameState GameState) GameState {
+	gameState.Tries = cloneTries(gameState.Tries)
+	if gameState.Candidates != nil {
+		gameState.Candidates = append([]string{}, gameState.Candidates...)
+	}
+
+	if gameState.Boards != nil {
+		boards := make([]Board, len(gameState.Boards))
+		for i, board := range gameState.Boards {
+			board.Tries = cloneTries(board.Tries)
+			boards[i] = board
+		}
+		gameState.Boards = boards
+	}
+	return gameState
+}
+
+// cloneTries copies a tries slice including each try's letter results
+func cloneTries(tries []GuessResult) []GuessResult {
+	cloned := make([]GuessResult, len(tries))
+	for i, try := range tries {
+		try.LetterResultArray = append([]LetterResult{}, try.LetterResultArray...)
+		cloned[i] = try
+	}
+	return cloned
+}
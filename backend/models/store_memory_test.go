@@ -0,0 +1,211 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// useMemoryStore swaps Games for an empty in-memory store for the rest of the test
+func useMemoryStore(t *testing.T) {
+	t.Helper()
+	previous := Games
+	Games = NewMemoryGameStore()
+	t.Cleanup(func() { Games = previous })
+}
+
+// saveTestGame saves a game built by newTestGameState after applying change
+func saveTestGame(t *testing.T, change func(gameState *GameState)) GameState {
+	t.Helper()
+	gameState := newTestGameState()
+	if change != nil {
+		change(&gameState)
+	}
+	if err := SaveGameState(&gameState); err != nil {
+		t.Fatalf("SaveGameState: %v", err)
+	}
+	return gameState
+}
+
+func TestMemoryStoreAssignsIDs(t *testing.T) {
+	useMemoryStore(t)
+
+	first := saveTestGame(t, nil)
+	second := saveTestGame(t, nil)
+	if first.ID != 1 || second.ID != 2 {
+		t.Fatalf("IDs = %d, %d, want 1, 2", first.ID, second.ID)
+	}
+
+	if _, err := GetGameStateByID(3); !errors.Is(err, ErrGameStateNotFound) {
+		t.Fatalf("GetGameStateByID(3) error = %v, want ErrGameStateNotFound", err)
+	}
+}
+
+func TestMemoryStoreDailyLimit(t *testing.T) {
+	useMemoryStore(t)
+
+	daily := func(gameState *GameState) {
+		gameState.Mode = "daily"
+		gameState.UserID = 7
+		gameState.DailyDate = "2026-01-02"
+	}
+	saveTestGame(t, daily)
+
+	gameState := newTestGameState()
+	daily(&gameState)
+	if err := SaveGameState(&gameState); !errors.Is(err, ErrDailyAlreadyPlayed) {
+		t.Fatalf("second daily error = %v, want ErrDailyAlreadyPlayed", err)
+	}
+}
+
+func TestPlayGuessWinsAndEndsGame(t *testing.T) {
+	useMemoryStore(t)
+	gameState := saveTestGame(t, nil)
+
+	played, err := PlayGuess(gameState.ID, gameState.Version, "crane", time.Now())
+	if err != nil {
+		t.Fatalf("PlayGuess: %v", err)
+	}
+	if played.GameStatus != StatusWon || len(played.Tries) != 1 || played.Version != 1 {
+		t.Fatalf("after winning guess: status %s, %d tries, version %d", played.GameStatus, len(played.Tries), played.Version)
+	}
+
+	var gameOver *GameOverError
+	if _, err := PlayGuess(gameState.ID, played.Version, "slate", time.Now()); !errors.As(err, &gameOver) {
+		t.Fatalf("guess on won game error = %v, want GameOverError", err)
+	}
+}
+
+func TestPlayGuessRejectsStaleVersion(t *testing.T) {
+	useMemoryStore(t)
+	gameState := saveTestGame(t, nil)
+
+	if _, err := PlayGuess(gameState.ID, gameState.Version, "slate", time.Now()); err != nil {
+		t.Fatalf("PlayGuess: %v", err)
+	}
+	if _, err := PlayGuess(gameState.ID, gameState.Version, "moody", time.Now()); !errors.Is(err, ErrStaleGameState) {
+		t.Fatalf("guess on old version error = %v, want ErrStaleGameState", err)
+	}
+}
+
+func TestRejectedGuessLeavesGameUntouched(t *testing.T) {
+	useMemoryStore(t)
+	gameState := saveTestGame(t, func(gameState *GameState) { gameState.HardMode = true })
+
+	played, err := PlayGuess(gameState.ID, gameState.Version, "trace", time.Now())
+	if err != nil {
+		t.Fatalf("PlayGuess: %v", err)
+	}
+
+	// TRACE revealed A and E in place, so MOODY breaks hard mode
+	var violation *HardModeViolation
+	if _, err := PlayGuess(gameState.ID, played.Version, "moody", time.Now()); !errors.As(err, &violation) {
+		t.Fatalf("hard mode guess error = %v, want HardModeViolation", err)
+	}
+
+	stored, err := GetGameStateByID(gameState.ID)
+	if err != nil {
+		t.Fatalf("GetGameStateByID: %v", err)
+	}
+	if len(stored.Tries) != 1 || stored.Version != played.Version {
+		t.Fatalf("rejected guess was stored: %d tries, version %d", len(stored.Tries), stored.Version)
+	}
+}
+
+func TestLeaveGameStateOnlyEndsPlayingGames(t *testing.T) {
+	useMemoryStore(t)
+	gameState := saveTestGame(t, nil)
+
+	if err := gameState.LeaveGameState(); err != nil {
+		t.Fatalf("LeaveGameState: %v", err)
+	}
+	if err := gameState.LeaveGameState(); err != nil {
+		t.Fatalf("second LeaveGameState: %v", err)
+	}
+
+	var transition *StatusTransitionError
+	if err := gameState.TimeoutGameState(); !errors.As(err, &transition) {
+		t.Fatalf("timeout after leaving error = %v, want StatusTransitionError", err)
+	}
+
+	stored, err := GetGameStateByID(gameState.ID)
+	if err != nil {
+		t.Fatalf("GetGameStateByID: %v", err)
+	}
+	if stored.GameStatus != StatusAbandoned {
+		t.Fatalf("stored status = %s, want %s", stored.GameStatus, StatusAbandoned)
+	}
+}
+
+func TestLeaveGameStateLosesUnsolvedBoards(t *testing.T) {
+	useMemoryStore(t)
+	gameState := saveTestGame(t, func(gameState *GameState) {
+		gameState.TargetWord = ""
+		gameState.MaxTries = 7
+		gameState.Boards = newBoards([]string{"CRANE", "MOODY"})
+	})
+
+	played, err := PlayGuess(gameState.ID, gameState.Version, "crane", time.Now())
+	if err != nil {
+		t.Fatalf("PlayGuess: %v", err)
+	}
+	if err := played.LeaveGameState(); err != nil {
+		t.Fatalf("LeaveGameState: %v", err)
+	}
+
+	stored, err := GetGameStateByID(gameState.ID)
+	if err != nil {
+		t.Fatalf("GetGameStateByID: %v", err)
+	}
+	if stored.Boards[0].Status != StatusWon || stored.Boards[1].Status != StatusLost {
+		t.Fatalf("stored board statuses = %s, %s, want %s, %s", stored.Boards[0].Status, stored.Boards[1].Status, StatusWon, StatusLost)
+	}
+	if stored.Version != played.Version {
+		t.Fatalf("stored version = %d, returned game has %d", stored.Version, played.Version)
+	}
+}
+
+func TestTimeoutGameStateCommitsAbsurdleWord(t *testing.T) {
+	useMemoryStore(t)
+	gameState := saveTestGame(t, func(gameState *GameState) {
+		gameState.Mode = "absurdle"
+		gameState.TargetWord = ""
+		gameState.TimeLimit = 0
+		gameState.Candidates = []string{"CRANE", "CRATE", "GRATE"}
+	})
+
+	if err := gameState.TimeoutGameState(); err != nil {
+		t.Fatalf("TimeoutGameState: %v", err)
+	}
+
+	stored, err := GetGameStateByID(gameState.ID)
+	if err != nil {
+		t.Fatalf("GetGameStateByID: %v", err)
+	}
+	if stored.TargetWord != "CRANE" || len(stored.Candidates) != 1 {
+		t.Fatalf("stored target %q with candidates %v, want CRANE alone", stored.TargetWord, stored.Candidates)
+	}
+}
+
+func TestTimeoutOverdueGames(t *testing.T) {
+	useMemoryStore(t)
+	now := time.Now()
+	overdue := saveTestGame(t, func(gameState *GameState) { gameState.GuessDeadline = now.Add(-time.Minute) })
+	inGrace := saveTestGame(t, func(gameState *GameState) { gameState.GuessDeadline = now.Add(-GuessDeadlineGrace / 2) })
+	untimed := saveTestGame(t, func(gameState *GameState) { gameState.TimeLimit = 0 })
+
+	if err := TimeoutOverdueGames(now); err != nil {
+		t.Fatalf("TimeoutOverdueGames: %v", err)
+	}
+
+	want := map[int64]string{overdue.ID: StatusTimeout, inGrace.ID: StatusPlaying, untimed.ID: StatusPlaying}
+	for id, status := range want {
+		stored, err := GetGameStateByID(id)
+		if err != nil {
+			t.Fatalf("GetGameStateByID(%d): %v", id, err)
+		}
+		if stored.GameStatus != status {
+			t.Errorf("game %d status = %s, want %s", id, stored.GameStatus, status)
+		}
+	}
+}
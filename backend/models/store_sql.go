@@ -0,0 +1,552 @@
+// SQL Game Store - SQLite and PostgreSQL implementations of GameStore
+//
+// ARCHITECTURE DECISION: One implementation parameterised by dialect
+// - Both databases share the same table layout and almost all of the SQL
+// - database.Dialect rewrites placeholders and date expressions for PostgreSQL
+// - Each guess is also copied into the guesses table in the same transaction, for analytics
+//   (single-board games only, see insertGuess)
+//
+// CONCURRENCY:
+// - SQLite: transactions begin IMMEDIATE (see database.InitDB), so writers are serialized
+// - PostgreSQL: READ COMMITTED; the version guards on UPDATE plus the
+//   daily unique index catch what a concurrent transaction changed
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"wordle-backend/database"
+)
+
+type sqlGameStore struct {
+	db      *sql.DB
+	dialect database.Dialect
+}
+
+// NewSQLiteGameStore returns a GameStore backed by a SQLite database
+func NewSQLiteGameStore(db *sql.DB) GameStore {
+	return &sqlGameStore{db: db, dialect: database.SQLite}
+}
+
+// NewPostgresGameStore returns a GameStore backed by a PostgreSQL database
+func NewPostgresGameStore(db *sql.DB) GameStore {
+	return &sqlGameStore{db: db, dialect: database.Postgres}
+}
+
+// gameStateDataColumns lists every game_states column except the generated id
+const gameStateDataColumns = `target_word, tries, boards, candidates, game_status, mode, max_tries, word_size, language, time_limit, guess_deadline, user_id, daily_date, challenge_id, seed, seeded, list_version, hard_mode, version, created_at, updated_at`
+
+// gameStateColumns lists the game_states columns in the order scanGameState expects
+const gameStateColumns = `id, ` + gameStateDataColumns
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// CreateGame inserts a new game and sets gameState.ID from the generated id column
+// CONCURRENCY: The daily and challenge checks and insert share one transaction, and their
+// unique indexes turn a lost race into ErrDailyAlreadyPlayed or ErrChallengeAlreadyPlayed as well
+func (s *sqlGameStore) CreateGame(gameState *GameState) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin saving game state: %v", err)
+	}
+	defer tx.Rollback()
+
+	gameID, err := s.insertGame(tx, *gameState)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit game state: %v", err)
+	}
+
+	gameState.ID = gameID
+	return nil
+}
+
+// CreateGuestGame creates the guest account, its session and the game in one transaction,
+// so a request that fails leaves no orphan guest behind
+func (s *sqlGameStore) CreateGuestGame(gameState *GameState) (string, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return "", fmt.Errorf("failed to begin saving game state: %v", err)
+	}
+	defer tx.Rollback()
+
+	guest, token, err := createGuestUser(tx)
+	if err != nil {
+		return "", err
+	}
+
+	owned := *gameState
+	owned.UserID = guest.ID
+	gameID, err := s.insertGame(tx, owned)
+	if err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit game state: %v", err)
+	}
+
+	gameState.UserID = guest.ID
+	gameState.ID = gameID
+	return token, nil
+}
+
+// insertGame runs the daily and challenge checks and inserts a game, returning its ID
+func (s *sqlGameStore) insertGame(tx *sql.Tx, gameState GameState) (int64, error) {
+	triesJSON, err := json.Marshal(gameState.Tries)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal tries to JSON: %v", err)
+	}
+	boardsJSON, err := marshalBoards(gameState.Boards)
+	if err != nil {
+		return 0, err
+	}
+	candidatesJSON, err := marshalCandidates(gameState.Candidates)
+	if err != nil {
+		return 0, err
+	}
+
+	if gameState.Mode == "daily" {
+		played, err := s.hasPlayedDaily(tx, gameState.UserID, gameState.DailyDate, gameState.Language, gameState.WordSize)
+		if err != nil {
+			return 0, err
+		}
+		if played {
+			return 0, ErrDailyAlreadyPlayed
+		}
+	}
+	if gameState.ChallengeID != 0 {
+		played, err := s.hasPlayedChallenge(tx, gameState.UserID, gameState.ChallengeID)
+		if err != nil {
+			return 0, err
+		}
+		if played {
+			return 0, ErrChallengeAlreadyPlayed
+		}
+	}
+
+	query := `
+		INSERT INTO game_states (` + gameStateDataColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`
+
+	var gameID int64
+	err = tx.QueryRow(s.dialect.Rebind(query), gameState.TargetWord, string(triesJSON), nullString(boardsJSON), nullString(candidatesJSON), gameState.GameStatus, gameState.Mode, gameState.MaxTries, gameState.WordSize, gameState.Language, gameState.TimeLimit, nullTime(gameState.GuessDeadline), nullInt64(gameState.UserID), nullString(gameState.DailyDate), nullInt64(gameState.ChallengeID), nullInt64(gameState.Seed), gameState.Seeded, nullString(gameState.ListVersion), gameState.HardMode, gameState.Version, gameState.CreatedAt, gameState.UpdatedAt).Scan(&gameID)
+	if gameState.Mode == "daily" && database.IsUniqueViolation(err) {
+		return 0, ErrDailyAlreadyPlayed
+	}
+	if gameState.ChallengeID != 0 && database.IsUniqueViolation(err) {
+		return 0, ErrChallengeAlreadyPlayed
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to save game state: %v", err)
+	}
+
+	return gameID, nil
+}
+
+// hasPlayedDaily reports whether a player already started the given daily puzzle
+func (s *sqlGameStore) hasPlayedDaily(db queryRower, userID int64, dailyDate string, language string, wordSize int) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM game_states
+		WHERE mode = 'daily' AND user_id = ? AND daily_date = ? AND language = ? AND word_size = ?
+	`
+
+	var count int
+	err := db.QueryRow(s.dialect.Rebind(query), userID, dailyDate, language, wordSize).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check daily games: %v", err)
+	}
+
+	return count > 0, nil
+}
+
+// hasPlayedChallenge reports whether a player already started a game on the given challenge
+func (s *sqlGameStore) hasPlayedChallenge(db queryRower, userID int64, challengeID int64) (bool, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM game_states
+		WHERE challenge_id = ? AND user_id = ?
+	`
+
+	var count int
+	err := db.QueryRow(s.dialect.Rebind(query), challengeID, userID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check challenge games: %v", err)
+	}
+
+	return count > 0, nil
+}
+
+func (s *sqlGameStore) GetGame(gameStateID int64) (GameState, error) {
+	return s.getGame(s.db, gameStateID)
+}
+
+func (s *sqlGameStore) getGame(db queryRower, gameStateID int64) (GameState, error) {
+	query := `
+		SELECT ` + gameStateColumns + `
+		FROM game_states
+		WHERE id = ?
+	`
+
+	gameState, err := scanGameState(db.QueryRow(s.dialect.Rebind(query), gameStateID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return GameState{}, ErrGameStateNotFound
+	}
+	if err != nil {
+		return GameState{}, fmt.Errorf("failed to load game state: %v", err)
+	}
+
+	return gameState, nil
+}
+
+// ListGames pages through game_states with keyset pagination on id
+func (s *sqlGameStore) ListGames(filter GameStateFilter) ([]GameState, int64, error) {
+	gameStates := []GameState{}
+	conditions := []string{"1 = 1"}
+	args := []any{}
+
+	if filter.GameStatus != "" {
+		conditions = append(conditions, "game_status = ?")
+		args = append(args, filter.GameStatus)
+	}
+	if filter.Mode != "" {
+		conditions = append(conditions, "mode = ?")
+		args = append(args, filter.Mode)
+	}
+	if filter.WordSize != 0 {
+		conditions = append(conditions, "word_size = ?")
+		args = append(args, filter.WordSize)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		conditions = append(conditions, s.dialect.Timestamp("created_at")+" >= "+s.dialect.Timestamp("?"))
+		args = append(args, filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		conditions = append(conditions, s.dialect.Timestamp("created_at")+" < "+s.dialect.Timestamp("?"))
+		args = append(args, filter.CreatedBefore)
+	}
+
+	orderBy := "id DESC"
+	if filter.AfterID != 0 {
+		if filter.Order == "asc" {
+			conditions = append(conditions, "id > ?")
+		} else {
+			conditions = append(conditions, "id < ?")
+		}
+		args = append(args, filter.AfterID)
+	}
+	if filter.Order == "asc" {
+		orderBy = "id ASC"
+	}
+
+	// One extra row tells us whether another page exists
+	query := `
+		SELECT ` + gameStateColumns + `
+		FROM game_states
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY ` + orderBy + `
+		LIMIT ?
+	`
+	args = append(args, filter.Limit+1)
+
+	rows, err := s.db.Query(s.dialect.Rebind(query), args...)
+	if err != nil {
+		return gameStates, 0, fmt.Errorf("failed to list game states: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		gameState, err := scanGameState(rows)
+		if err != nil {
+			return []GameState{}, 0, err
+		}
+
+		gameStates = append(gameStates, gameState)
+	}
+	if err := rows.Err(); err != nil {
+		return []GameState{}, 0, fmt.Errorf("failed to read game states: %v", err)
+	}
+
+	gameStates, nextCursor := trimPage(gameStates, filter.Limit)
+	return gameStates, nextCursor, nil
+}
+
+func (s *sqlGameStore) ListOverdueGames(before time.Time) ([]GameState, error) {
+	gameStates := []GameState{}
+	query := `
+		SELECT ` + gameStateColumns + `
+		FROM game_states
+		WHERE game_status = ? AND guess_deadline IS NOT NULL AND ` + s.dialect.Timestamp("guess_deadline") + ` < ` + s.dialect.Timestamp("?") + `
+		ORDER BY id ASC
+	`
+
+	rows, err := s.db.Query(s.dialect.Rebind(query), StatusPlaying, before)
+	if err != nil {
+		return gameStates, fmt.Errorf("failed to list overdue game states: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		gameState, err := scanGameState(rows)
+		if err != nil {
+			return []GameState{}, err
+		}
+
+		gameStates = append(gameStates, gameState)
+	}
+	if err := rows.Err(); err != nil {
+		return []GameState{}, fmt.Errorf("failed to read overdue game states: %v", err)
+	}
+
+	return gameStates, nil
+}
+
+// AppendGuess runs read-validate-append in one transaction
+// CONCURRENCY: The UPDATE is guarded by the version read at the start, so a
+// concurrent guess that committed first makes this one fail with ErrStaleGameState
+func (s *sqlGameStore) AppendGuess(gameStateID int64, expectedVersion int, play func(gameState *GameState) error) (GameState, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return GameState{}, fmt.Errorf("failed to begin guess: %v", err)
+	}
+	defer tx.Rollback()
+
+	gameState, err := s.getGame(tx, gameStateID)
+	if err != nil {
+		return GameState{}, err
+	}
+	if gameState.Version != expectedVersion {
+		return GameState{}, ErrStaleGameState
+	}
+
+	triesBefore := len(gameState.Tries)
+	previousUpdate := gameState.UpdatedAt
+	if err := play(&gameState); err != nil {
+		return GameState{}, err
+	}
+
+	triesJSON, err := json.Marshal(gameState.Tries)
+	if err != nil {
+		return GameState{}, fmt.Errorf("failed to marshal tries to JSON: %v", err)
+	}
+	boardsJSON, err := marshalBoards(gameState.Boards)
+	if err != nil {
+		return GameState{}, err
+	}
+	candidatesJSON, err := marshalCandidates(gameState.Candidates)
+	if err != nil {
+		return GameState{}, err
+	}
+
+	query := `
+		UPDATE game_states
+		SET target_word = ?, tries = ?, boards = ?, candidates = ?, game_status = ?, guess_deadline = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ?
+	`
+
+	result, err := tx.Exec(s.dialect.Rebind(query), gameState.TargetWord, string(triesJSON), nullString(boardsJSON), nullString(candidatesJSON), gameState.GameStatus, nullTime(gameState.GuessDeadline), gameState.UpdatedAt, gameState.ID, expectedVersion)
+	if err != nil {
+		return GameState{}, fmt.Errorf("failed to update game state: %v", err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return GameState{}, fmt.Errorf("failed to check game state update: %v", err)
+	}
+	if updatedRows == 0 {
+		return GameState{}, ErrStaleGameState
+	}
+
+	if len(gameState.Tries) > triesBefore && !gameState.IsMultiBoard() {
+		if err := s.insertGuess(tx, gameState, triesBefore, previousUpdate); err != nil {
+			return GameState{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return GameState{}, fmt.Errorf("failed to commit guess: %v", err)
+	}
+
+	gameState.Version++
+	return gameState, nil
+}
+
+// insertGuess copies the try at guessIndex into the guesses analytics table
+// Latency is measured from the game's previous write: its creation or the previous guess
+// Multi-board games get no rows: their tries have no pattern of their own, and one
+// pattern per board does not fit the table's key of one row per guess
+func (s *sqlGameStore) insertGuess(tx *sql.Tx, gameState GameState, guessIndex int, previousUpdate time.Time) error {
+	guess := gameState.Tries[guessIndex]
+	query := `
+		INSERT INTO guesses (game_id, guess_index, word, results, is_correct, submitted_at, latency_ms)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	latency := gameState.UpdatedAt.Sub(previousUpdate).Milliseconds()
+	_, err := tx.Exec(s.dialect.Rebind(query), gameState.ID, guessIndex, guess.GuessWord, guess.Pattern(), guess.IsCorrect, gameState.UpdatedAt, latency)
+	if err != nil {
+		return fmt.Errorf("failed to record guess: %v", err)
+	}
+
+	return nil
+}
+
+// SetStatus is a single guarded UPDATE, so it needs no explicit transaction
+func (s *sqlGameStore) SetStatus(gameState GameState, expectedVersion int) error {
+	boardsJSON, err := marshalBoards(gameState.Boards)
+	if err != nil {
+		return err
+	}
+	candidatesJSON, err := marshalCandidates(gameState.Candidates)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE game_states
+		SET target_word = ?, boards = ?, candidates = ?, game_status = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ?
+	`
+
+	result, err := s.db.Exec(s.dialect.Rebind(query), gameState.TargetWord, nullString(boardsJSON), nullString(candidatesJSON), gameState.GameStatus, gameState.UpdatedAt, gameState.ID, expectedVersion)
+	if err != nil {
+		return fmt.Errorf("failed to set game state status to %s: %v", gameState.GameStatus, err)
+	}
+
+	updatedRows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check game state update: %v", err)
+	}
+	if updatedRows == 0 {
+		return ErrStaleGameState
+	}
+
+	return nil
+}
+
+// scanGameState reads one game_states row selected with gameStateColumns
+// Nullable columns are mapped onto zero values so callers never see sql.Null types
+func scanGameState(row rowScanner) (GameState, error) {
+	var gameState GameState
+	var triesJSON string
+	var boardsJSON sql.NullString
+	var candidatesJSON sql.NullString
+	var guessDeadline sql.NullTime
+	var userID sql.NullInt64
+	var dailyDate sql.NullString
+	var challengeID sql.NullInt64
+	var seed sql.NullInt64
+	var listVersion sql.NullString
+
+	err := row.Scan(
+		&gameState.ID,
+		&gameState.TargetWord,
+		&triesJSON,
+		&boardsJSON,
+		&candidatesJSON,
+		&gameState.GameStatus,
+		&gameState.Mode,
+		&gameState.MaxTries,
+		&gameState.WordSize,
+		&gameState.Language,
+		&gameState.TimeLimit,
+		&guessDeadline,
+		&userID,
+		&dailyDate,
+		&challengeID,
+		&seed,
+		&gameState.Seeded,
+		&listVersion,
+		&gameState.HardMode,
+		&gameState.Version,
+		&gameState.CreatedAt,
+		&gameState.UpdatedAt,
+	)
+	if err != nil {
+		return GameState{}, fmt.Errorf("failed to scan game state: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(triesJSON), &gameState.Tries); err != nil {
+		return GameState{}, fmt.Errorf("failed to unmarshal tries: %v", err)
+	}
+	if boardsJSON.Valid {
+		if err := json.Unmarshal([]byte(boardsJSON.String), &gameState.Boards); err != nil {
+			return GameState{}, fmt.Errorf("failed to unmarshal boards: %v", err)
+		}
+	}
+	if candidatesJSON.Valid {
+		if err := json.Unmarshal([]byte(candidatesJSON.String), &gameState.Candidates); err != nil {
+			return GameState{}, fmt.Errorf("failed to unmarshal candidates: %v", err)
+		}
+	}
+	gameState.GuessDeadline = guessDeadline.Time
+	gameState.UserID = userID.Int64
+	gameState.DailyDate = dailyDate.String
+	gameState.ChallengeID = challengeID.Int64
+	gameState.Seed = seed.Int64
+	gameState.ListVersion = listVersion.String
+
+	return gameState, nil
+}
+
+// marshalBoards encodes the boards of a multi-board game, or "" (stored as NULL) for single-board games
+func marshalBoards(boards []Board) (string, error) {
+	if len(boards) == 0 {
+		return "", nil
+	}
+	boardsJSON, err := json.Marshal(boards)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal boards to JSON: %v", err)
+	}
+	return string(boardsJSON), nil
+}
+
+// marshalCandidates encodes the candidates of an absurdle game, or "" (stored as NULL) for other games
+func marshalCandidates(candidates []string) (string, error) {
+	if len(candidates) == 0 {
+		return "", nil
+	}
+	candidatesJSON, err := json.Marshal(candidates)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal candidates to JSON: %v", err)
+	}
+	return string(candidatesJSON), nil
+}
+
+// nullString stores empty strings as NULL so optional columns stay queryable with IS NULL
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// nullInt64 stores zero IDs as NULL, e.g. games without an owning account
+func nullInt64(value int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: value, Valid: value != 0}
+}
+
+// nullTime stores zero times as NULL, e.g. games without a per-guess deadline
+func nullTime(value time.Time) sql.NullTime {
+	return sql.NullTime{Time: value, Valid: !value.IsZero()}
+}
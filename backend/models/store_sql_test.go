@@ -0,0 +1,147 @@
+package models
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+	"wordle-backend/database"
+)
+
+// openTestDB points database.DB and Games at a fresh, fully migrated SQLite file
+func openTestDB(t *testing.T) {
+	t.Helper()
+	t.Setenv("DATABASE_DRIVER", "sqlite")
+	t.Setenv("DATABASE_URL", filepath.Join(t.TempDir(), "test.db"))
+
+	database.InitDB()
+	Games = NewSQLiteGameStore(database.DB)
+	t.Cleanup(func() { database.DB.Close() })
+}
+
+// newTestGameState returns a playing speed game that can be saved without word lists
+func newTestGameState() GameState {
+	now := time.Now()
+	return GameState{
+		TargetWord: "CRANE",
+		Tries:      []GuessResult{},
+		GameStatus: StatusPlaying,
+		Mode:       "speed",
+		MaxTries:   6,
+		WordSize:   5,
+		Language:   "en",
+		TimeLimit:  45,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// TestSaveGameStateConcurrent creates hundreds of games at once; the database
+// assigns every ID, so all of them must succeed with distinct IDs
+func TestSaveGameStateConcurrent(t *testing.T) {
+	openTestDB(t)
+
+	const games = 300
+	ids := make([]int64, games)
+	errs := make([]error, games)
+
+	var wait sync.WaitGroup
+	for i := range games {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			gameState := newTestGameState()
+			errs[i] = SaveGameState(&gameState)
+			ids[i] = gameState.ID
+		}()
+	}
+	wait.Wait()
+
+	seen := make(map[int64]bool, games)
+	for i := range games {
+		if errs[i] != nil {
+			t.Fatalf("game %d: SaveGameState failed: %v", i, errs[i])
+		}
+		if ids[i] == 0 {
+			t.Fatalf("game %d: no ID assigned", i)
+		}
+		if seen[ids[i]] {
+			t.Fatalf("game %d: duplicate ID %d", i, ids[i])
+		}
+		seen[ids[i]] = true
+	}
+
+	var stored int
+	if err := database.DB.QueryRow(`SELECT COUNT(*) FROM game_states`).Scan(&stored); err != nil {
+		t.Fatalf("counting games: %v", err)
+	}
+	if stored != games {
+		t.Fatalf("stored %d games, want %d", stored, games)
+	}
+}
+
+// TestLeaveLosesToConcurrentGuess leaves an absurdle game from a copy read before a guess
+// landed; the leave must fail instead of writing back the candidates the guess narrowed
+func TestLeaveLosesToConcurrentGuess(t *testing.T) {
+	stores := map[string]func(t *testing.T){
+		"memory": useMemoryStore,
+		"sqlite": openTestDB,
+	}
+	for name, useStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			useStore(t)
+			gameState := saveTestGame(t, func(gameState *GameState) {
+				gameState.Mode = "absurdle"
+				gameState.TargetWord = ""
+				gameState.TimeLimit = 0
+				gameState.Candidates = []string{"CRANE", "CRATE", "GRATE", "MOODY"}
+			})
+
+			leaving, err := GetGameStateByID(gameState.ID)
+			if err != nil {
+				t.Fatalf("GetGameStateByID: %v", err)
+			}
+			played, err := PlayGuess(gameState.ID, gameState.Version, "slate", time.Now())
+			if err != nil {
+				t.Fatalf("PlayGuess: %v", err)
+			}
+			if err := leaving.LeaveGameState(); !errors.Is(err, ErrStaleGameState) {
+				t.Fatalf("LeaveGameState after a guess error = %v, want ErrStaleGameState", err)
+			}
+
+			stored, err := GetGameStateByID(gameState.ID)
+			if err != nil {
+				t.Fatalf("GetGameStateByID: %v", err)
+			}
+			if stored.GameStatus != StatusPlaying || stored.Version != played.Version || len(stored.Tries) != 1 {
+				t.Fatalf("stored game: status %s, version %d, %d tries, want the guess alone", stored.GameStatus, stored.Version, len(stored.Tries))
+			}
+			if !slices.Equal(stored.Candidates, []string{"CRATE", "GRATE"}) {
+				t.Fatalf("stored candidates = %v, want [CRATE GRATE]", stored.Candidates)
+			}
+		})
+	}
+}
+
+// TestListOverdueGames checks the deadline comparison SQLite makes on stored timestamps
+func TestListOverdueGames(t *testing.T) {
+	openTestDB(t)
+	now := time.Now()
+	overdue := saveTestGame(t, func(gameState *GameState) { gameState.GuessDeadline = now.Add(-time.Second) })
+	saveTestGame(t, func(gameState *GameState) { gameState.GuessDeadline = now.Add(time.Second) })
+	saveTestGame(t, func(gameState *GameState) {
+		gameState.GuessDeadline = now.Add(-time.Second)
+		gameState.GameStatus = StatusLost
+	})
+	saveTestGame(t, func(gameState *GameState) { gameState.TimeLimit = 0 })
+
+	gameStates, err := Games.ListOverdueGames(now)
+	if err != nil {
+		t.Fatalf("ListOverdueGames: %v", err)
+	}
+	if len(gameStates) != 1 || gameStates[0].ID != overdue.ID {
+		t.Fatalf("ListOverdueGames returned %d games, want game %d alone", len(gameStates), overdue.ID)
+	}
+}
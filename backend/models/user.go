@@ -0,0 +1,232 @@
+// User Models - Player accounts and login sessions
+//
+// ARCHITECTURE DECISION: Opaque bearer tokens backed by a sessions table
+// - Tokens are random and meaningless on their own, so they can be revoked by deleting a row
+// - Only a SHA-256 of each token is stored
+// - Passwords are hashed with bcrypt
+//
+// TRADE-OFFS CONSIDERED:
+// - JWT vs Opaque tokens: Opaque chosen to avoid signing key management and allow revocation
+// - One lookup per authenticated request is cheap with the token hash as primary key
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+	"wordle-backend/database"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// SessionDuration is how long a login token stays valid
+const SessionDuration = 30 * 24 * time.Hour
+
+// GuestSessionDuration is longer since a lost guest token means lost games
+const GuestSessionDuration = 365 * 24 * time.Hour
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{3,32}$`)
+
+var (
+	ErrUsernameTaken      = errors.New("username is already taken")
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrInvalidSession     = errors.New("invalid or expired session token")
+	ErrNotGuest           = errors.New("token does not belong to a guest session")
+	ErrGuestCannotClaim   = errors.New("guest sessions cannot claim games, register an account first")
+)
+
+// User represents a registered player account
+type User struct {
+	ID           int64     `json:"id"`
+	Username     string    `json:"username"`
+	PasswordHash string    `json:"-"`
+	IsGuest      bool      `json:"isGuest"`
+	IsAdmin      bool      `json:"isAdmin"` // May manage word lists through /admin
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// RegisterUser validates credentials and stores a new account
+func RegisterUser(username string, password string) (User, error) {
+	if !usernamePattern.MatchString(username) {
+		return User{}, fmt.Errorf("username must be 3-32 letters, digits, '_' or '-'")
+	}
+	if len(password) < 8 || len(password) > 72 {
+		return User{}, fmt.Errorf("password must be between 8 and 72 characters")
+	}
+	
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to hash password: %v", err)
+	}
+	
+	user := User{
+		Username:     username,
+		PasswordHash: string(passwordHash),
+		CreatedAt:    time.Now(),
+	}
+	
+	query := `
+		INSERT INTO users (username, password_hash, created_at)
+		VALUES (?, ?, ?)
+		RETURNING id
+	`
+	
+	// CONCURRENCY: The unique index decides who gets a name, so two sign-ups racing
+	// for the same one cannot both pass a check made before the insert
+	err = database.DB.QueryRow(database.Driver.Rebind(query), user.Username, user.PasswordHash, user.CreatedAt).Scan(&user.ID)
+	if database.IsUniqueViolation(err) {
+		return User{}, ErrUsernameTaken
+	}
+	if err != nil {
+		return User{}, fmt.Errorf("failed to save user: %v", err)
+	}
+	
+	return user, nil
+}
+
+// AuthenticateUser checks a username and password pair
+// SECURITY: Same error for unknown user and wrong password to avoid account enumeration
+func AuthenticateUser(username string, password string) (User, error) {
+	user, err := GetUserByUsername(username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, ErrInvalidCredentials
+	}
+	if err != nil {
+		return User{}, err
+	}
+	
+	if user.IsGuest {
+		return User{}, ErrInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return User{}, ErrInvalidCredentials
+	}
+	
+	return user, nil
+}
+
+// GetUserByUsername loads an account; returns sql.ErrNoRows (wrapped) when missing
+func GetUserByUsername(username string) (User, error) {
+	query := `
+		SELECT id, username, password_hash, is_guest, is_admin, created_at
+		FROM users
+		WHERE username = ?
+	`
+	
+	var user User
+	err := database.DB.QueryRow(database.Driver.Rebind(query), username).Scan(&user.ID, &user.Username, &user.PasswordHash, &user.IsGuest, &user.IsAdmin, &user.CreatedAt)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to load user: %w", err)
+	}
+	
+	return user, nil
+}
+
+func GetUserByID(userID int64) (User, error) {
+	query := `
+		SELECT id, username, password_hash, is_guest, is_admin, created_at
+		FROM users
+		WHERE id = ?
+	`
+	
+	var user User
+	err := database.DB.QueryRow(database.Driver.Rebind(query), userID).Scan(&user.ID, &user.Username, &user.PasswordHash, &user.IsGuest, &user.IsAdmin, &user.CreatedAt)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to load user: %w", err)
+	}
+	
+	return user, nil
+}
+
+// SetUserAdmin grants or revokes administrator rights for a registered account
+// SECURITY: Guests cannot be administrators, they have no password to protect the account
+func SetUserAdmin(username string, isAdmin bool) error {
+	user, err := GetUserByUsername(username)
+	if err != nil {
+		return err
+	}
+	if user.IsGuest {
+		return fmt.Errorf("%s is a guest account", username)
+	}
+	
+	_, err = database.DB.Exec(database.Driver.Rebind(`UPDATE users SET is_admin = ? WHERE id = ?`), isAdmin, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %v", err)
+	}
+	
+	return nil
+}
+
+// CreateSession issues a new bearer token for a user
+// The plain token is returned once and never stored
+func CreateSession(userID int64) (string, error) {
+	return createSession(database.DB, userID, SessionDuration)
+}
+
+// createSession saves a session through db, which may be a transaction
+func createSession(db execer, userID int64, duration time.Duration) (string, error) {
+	token, err := randomHex(32)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate session token: %v", err)
+	}
+	
+	now := time.Now()
+	query := `
+		INSERT INTO sessions (token_hash, user_id, created_at, expires_at)
+		VALUES (?, ?, ?, ?)
+	`
+	
+	_, err = db.Exec(database.Driver.Rebind(query), hashToken(token), userID, now, now.Add(duration))
+	if err != nil {
+		return "", fmt.Errorf("failed to save session: %v", err)
+	}
+	
+	return token, nil
+}
+
+// GetUserIDBySessionToken resolves a bearer token to the owning user ID
+func GetUserIDBySessionToken(token string) (int64, error) {
+	query := `
+		SELECT user_id
+		FROM sessions
+		WHERE token_hash = ? AND expires_at > ?
+	`
+	
+	var userID int64
+	err := database.DB.QueryRow(database.Driver.Rebind(query), hashToken(token), time.Now()).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrInvalidSession
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to load session: %v", err)
+	}
+	
+	return userID, nil
+}
+
+// DeleteSession revokes a bearer token (logout)
+func DeleteSession(token string) error {
+	_, err := database.DB.Exec(database.Driver.Rebind(`DELETE FROM sessions WHERE token_hash = ?`), hashToken(token))
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %v", err)
+	}
+	return nil
+}
+
+func randomHex(byteCount int) (string, error) {
+	randomBytes := make([]byte, byteCount)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// TestRegisterUserConcurrent signs up the same username at once; the unique index
+// must let exactly one through and turn every other attempt into ErrUsernameTaken
+func TestRegisterUserConcurrent(t *testing.T) {
+	openTestDB(t)
+
+	const attempts = 8
+	errs := make([]error, attempts)
+
+	var wait sync.WaitGroup
+	for i := range attempts {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			_, errs[i] = RegisterUser("player1", "correct horse")
+		}()
+	}
+	wait.Wait()
+
+	registered := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			registered++
+		case !errors.Is(err, ErrUsernameTaken):
+			t.Fatalf("attempt %d: RegisterUser error = %v, want ErrUsernameTaken", i, err)
+		}
+	}
+	if registered != 1 {
+		t.Fatalf("%d registrations succeeded, want 1", registered)
+	}
+}
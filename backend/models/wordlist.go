@@ -0,0 +1,345 @@
+// Word List Administration - Runtime changes to the answer and guess lists
+//
+// ARCHITECTURE DECISION: Overrides in the database layered over the list files
+// - word_list_overrides holds the status of every word changed at runtime
+// - word_list_changes is an append-only audit trail of who changed what
+// - After each change the merged lists are swapped into the data package,
+//   so helpers.GetRandomWord and helpers.IsWordInList see them on the next call
+//
+// TRADE-OFFS CONSIDERED:
+// - Overrides vs Copying the lists into the database: the files stay the reviewed
+//   baseline, and a deploy with updated files still has the runtime changes applied on top
+// - Other server instances pick up changes on their next start (see LoadWordListOverrides)
+//
+// CONCURRENCY: wordListMutex serializes changes within this process, so two requests
+// cannot swap in lists built from different snapshots of the overrides
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"wordle-backend/data"
+	"wordle-backend/database"
+)
+
+// Actions an administrator can apply to a word
+const (
+	WordActionAddAnswer = "add-answer" // Make the word a possible target (and a valid guess)
+	WordActionAddGuess  = "add-guess"  // Accept the word as a guess
+	WordActionRetire    = "retire"     // Stop picking the word as a target, keep it guessable
+	WordActionBan       = "ban"        // Remove the word from both lists
+)
+
+// wordActionStatuses is the word status each action leads to
+var wordActionStatuses = map[string]string{
+	WordActionAddAnswer: data.StatusAnswer,
+	WordActionAddGuess:  data.StatusGuess,
+	WordActionRetire:    data.StatusGuess,
+	WordActionBan:       data.StatusBanned,
+}
+
+// ErrNoAnswersLeft is returned when a change would leave a word size without answers
+var ErrNoAnswersLeft = errors.New("change would leave no answers for this word size")
+
+var wordListMutex sync.Mutex
+
+// WordListOverride is a word whose status was changed at runtime
+type WordListOverride struct {
+	Language  string    `json:"language"`
+	WordSize  int       `json:"wordSize"`
+	Word      string    `json:"word"`
+	Status    string    `json:"status"`
+	UpdatedBy int64     `json:"updatedBy"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WordListChange is one audit trail entry
+// PreviousStatus is the status the word had right before the change
+type WordListChange struct {
+	ID             int64     `json:"id"`
+	UserID         int64     `json:"userId"`
+	Language       string    `json:"language"`
+	WordSize       int       `json:"wordSize"`
+	Word           string    `json:"word"`
+	Action         string    `json:"action"`
+	PreviousStatus string    `json:"previousStatus"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// RejectedWord is a word an action could not be applied to
+type RejectedWord struct {
+	Word   string `json:"word"`
+	Reason string `json:"reason"`
+}
+
+// WordListChangeResult reports what an action did to each submitted word
+type WordListChangeResult struct {
+	Changed   []string       `json:"changed"`
+	Unchanged []string       `json:"unchanged"`
+	Rejected  []RejectedWord `json:"rejected"`
+}
+
+// wordStatus returns a word's status in the given lists
+func wordStatus(lists *data.WordLists, language string, wordSize int, word string) string {
+	switch {
+	case lists.Answers(language, wordSize).Contains(word):
+		return data.StatusAnswer
+	case lists.Guesses(language, wordSize).Contains(word):
+		return data.StatusGuess
+	default:
+		return data.StatusBanned
+	}
+}
+
+// LoadWordListOverrides applies the stored overrides to the lists read from files
+// Must be called after database.InitDB and data.InitWordLists
+func LoadWordListOverrides() error {
+	wordListMutex.Lock()
+	defer wordListMutex.Unlock()
+
+	overrides, err := getWordListOverrides("", 0)
+	if err != nil {
+		return err
+	}
+	lists, err := data.DefaultLists().WithOverrides(toDataOverrides(overrides))
+	if err != nil {
+		return fmt.Errorf("failed to apply word list overrides: %v", err)
+	}
+
+	data.ReplaceLists(lists)
+	return nil
+}
+
+// ApplyWordListAction applies one action to a batch of words of one language and size
+// and records who did it
+// Invalid words are rejected individually; words already in the target state are unchanged
+func ApplyWordListAction(userID int64, language string, wordSize int, action string, words []string) (WordListChangeResult, error) {
+	result := WordListChangeResult{Changed: []string{}, Unchanged: []string{}, Rejected: []RejectedWord{}}
+	newStatus, ok := wordActionStatuses[action]
+	if !ok {
+		return result, fmt.Errorf("unknown word list action %q", action)
+	}
+	if err := data.CheckWordSize(language, wordSize); err != nil {
+		return result, err
+	}
+	wordLanguage, err := data.GetLanguage(language)
+	if err != nil {
+		return result, err
+	}
+
+	wordListMutex.Lock()
+	defer wordListMutex.Unlock()
+
+	current := data.Lists()
+	defaults := data.DefaultLists()
+	overrides, err := getWordListOverrides("", 0)
+	if err != nil {
+		return result, err
+	}
+	overrideIndex := make(map[string]int)
+	for i, override := range overrides {
+		if override.Language == language && override.WordSize == wordSize {
+			overrideIndex[override.Word] = i
+		}
+	}
+
+	now := time.Now()
+	changes := []WordListChange{}
+	for _, word := range words {
+		word, err := wordLanguage.NormalizeWord(word, wordSize)
+		if err != nil {
+			result.Rejected = append(result.Rejected, RejectedWord{Word: word, Reason: err.Error()})
+			continue
+		}
+
+		previousStatus := wordStatus(current, language, wordSize, word)
+		if action == WordActionRetire && previousStatus != data.StatusAnswer {
+			result.Rejected = append(result.Rejected, RejectedWord{Word: word, Reason: "not an answer"})
+			continue
+		}
+		if previousStatus == newStatus || (action == WordActionAddGuess && previousStatus == data.StatusAnswer) || slices.Contains(result.Changed, word) {
+			result.Unchanged = append(result.Unchanged, word)
+			continue
+		}
+
+		change := WordListChange{UserID: userID, Language: language, WordSize: wordSize, Word: word, Action: action, PreviousStatus: previousStatus, CreatedAt: now}
+		override := WordListOverride{Language: language, WordSize: wordSize, Word: word, Status: newStatus, UpdatedBy: userID, UpdatedAt: now}
+		if i, found := overrideIndex[word]; found {
+			overrides[i] = override
+		} else {
+			overrideIndex[word] = len(overrides)
+			overrides = append(overrides, override)
+		}
+		changes = append(changes, change)
+		result.Changed = append(result.Changed, word)
+	}
+	if len(changes) == 0 {
+		return result, nil
+	}
+
+	// Build the new lists before writing, so a rejected batch leaves nothing behind
+	lists, err := defaults.WithOverrides(toDataOverrides(overrides))
+	if err != nil {
+		return result, ErrNoAnswersLeft
+	}
+
+	if err := saveWordListChanges(changes, overrides, defaults); err != nil {
+		return result, err
+	}
+
+	data.ReplaceLists(lists)
+	return result, nil
+}
+
+// saveWordListChanges stores the changed overrides and their audit entries in one transaction
+// Words changed back to their status in the list files lose their override row
+func saveWordListChanges(changes []WordListChange, overrides []WordListOverride, defaults *data.WordLists) error {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin word list change: %v", err)
+	}
+	defer tx.Rollback()
+
+	changedWords := make(map[string]bool)
+	for _, change := range changes {
+		changedWords[change.Word] = true
+
+		query := `
+			INSERT INTO word_list_changes (user_id, language, word_size, word, action, previous_status, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`
+		_, err := tx.Exec(database.Driver.Rebind(query), change.UserID, change.Language, change.WordSize, change.Word, change.Action, change.PreviousStatus, change.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to record word list change: %v", err)
+		}
+	}
+
+	for _, override := range overrides {
+		if override.Language != changes[0].Language || override.WordSize != changes[0].WordSize || !changedWords[override.Word] {
+			continue
+		}
+
+		if override.Status == wordStatus(defaults, override.Language, override.WordSize, override.Word) {
+			query := `DELETE FROM word_list_overrides WHERE language = ? AND word_size = ? AND word = ?`
+			if _, err := tx.Exec(database.Driver.Rebind(query), override.Language, override.WordSize, override.Word); err != nil {
+				return fmt.Errorf("failed to delete word list override: %v", err)
+			}
+			continue
+		}
+
+		query := `
+			INSERT INTO word_list_overrides (language, word_size, word, status, updated_by, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (language, word_size, word) DO UPDATE
+			SET status = excluded.status, updated_by = excluded.updated_by, updated_at = excluded.updated_at
+		`
+		_, err := tx.Exec(database.Driver.Rebind(query), override.Language, override.WordSize, override.Word, override.Status, override.UpdatedBy, override.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to save word list override: %v", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit word list change: %v", err)
+	}
+	return nil
+}
+
+// GetWordListOverrides lists the runtime changes for one language and word size, oldest first
+func GetWordListOverrides(language string, wordSize int) ([]WordListOverride, error) {
+	return getWordListOverrides(language, wordSize)
+}
+
+// getWordListOverrides loads the overrides of one language and word size,
+// or of every language and size for "" and 0
+func getWordListOverrides(language string, wordSize int) ([]WordListOverride, error) {
+	overrides := []WordListOverride{}
+	query := `
+		SELECT language, word_size, word, status, updated_by, updated_at
+		FROM word_list_overrides
+		WHERE (? = '' OR language = ?) AND (? = 0 OR word_size = ?)
+		ORDER BY updated_at, word
+	`
+
+	rows, err := database.DB.Query(database.Driver.Rebind(query), language, language, wordSize, wordSize)
+	if err != nil {
+		return overrides, fmt.Errorf("failed to get word list overrides: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var override WordListOverride
+		if err := rows.Scan(&override.Language, &override.WordSize, &override.Word, &override.Status, &override.UpdatedBy, &override.UpdatedAt); err != nil {
+			return overrides, fmt.Errorf("failed to scan word list override: %v", err)
+		}
+		overrides = append(overrides, override)
+	}
+
+	return overrides, rows.Err()
+}
+
+// GetWordListChanges returns one page of the audit trail, newest first
+// language "" and wordSize 0 include every language and size; beforeID is the cursor
+// from the previous page; nextCursor is 0 when there are no older changes
+func GetWordListChanges(language string, wordSize int, beforeID int64, limit int) ([]WordListChange, int64, error) {
+	changes := []WordListChange{}
+	conditions := []string{"1 = 1"}
+	args := []any{}
+
+	if language != "" {
+		conditions = append(conditions, "language = ?")
+		args = append(args, language)
+	}
+	if wordSize != 0 {
+		conditions = append(conditions, "word_size = ?")
+		args = append(args, wordSize)
+	}
+	if beforeID != 0 {
+		conditions = append(conditions, "id < ?")
+		args = append(args, beforeID)
+	}
+
+	query := `
+		SELECT id, user_id, language, word_size, word, action, previous_status, created_at
+		FROM word_list_changes
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY id DESC
+		LIMIT ?
+	`
+	args = append(args, limit+1)
+
+	rows, err := database.DB.Query(database.Driver.Rebind(query), args...)
+	if err != nil {
+		return changes, 0, fmt.Errorf("failed to get word list changes: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var change WordListChange
+		if err := rows.Scan(&change.ID, &change.UserID, &change.Language, &change.WordSize, &change.Word, &change.Action, &change.PreviousStatus, &change.CreatedAt); err != nil {
+			return []WordListChange{}, 0, fmt.Errorf("failed to scan word list change: %v", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return []WordListChange{}, 0, fmt.Errorf("failed to read word list changes: %v", err)
+	}
+
+	if len(changes) <= limit {
+		return changes, 0, nil
+	}
+	changes = changes[:limit]
+	return changes, changes[len(changes)-1].ID, nil
+}
+
+func toDataOverrides(overrides []WordListOverride) []data.WordOverride {
+	dataOverrides := make([]data.WordOverride, 0, len(overrides))
+	for _, override := range overrides {
+		dataOverrides = append(dataOverrides, data.WordOverride{Language: override.Language, WordSize: override.WordSize, Word: override.Word, Status: override.Status})
+	}
+	return dataOverrides
+}
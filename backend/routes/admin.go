@@ -0,0 +1,244 @@
+// Admin Routes - Word list management at runtime
+//
+// SECURITY: Every route here sits behind RequireAuth and RequireAdmin (see routes.go)
+// and each change is written to the word list audit trail with the acting user
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"wordle-backend/data"
+	"wordle-backend/middlewares"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportBytes caps a bulk import body, comfortably above the largest list file
+const maxImportBytes = 1 << 20
+
+// adminWordSize parses the ?language query (default en) and the :wordSize path parameter,
+// writing a 400 if they have no lists
+func adminWordSize(context *gin.Context) (string, int, bool) {
+	language := context.DefaultQuery("language", data.DefaultLanguage)
+	if _, err := data.GetLanguage(language); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return "", 0, false
+	}
+	wordSize, err := strconv.Atoi(context.Param("wordSize"))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "wordSize must be one of " + data.SupportedWordSizes(language)})
+		return "", 0, false
+	}
+	if err := data.CheckWordSize(language, wordSize); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return "", 0, false
+	}
+	return language, wordSize, true
+}
+
+// addAction maps the "list" a word is added to onto its word list action
+func addAction(list string) (string, error) {
+	switch list {
+	case "", "answers":
+		return models.WordActionAddAnswer, nil
+	case "guesses":
+		return models.WordActionAddGuess, nil
+	default:
+		return "", fmt.Errorf("list must be answers or guesses, got %q", list)
+	}
+}
+
+// respondWordListChange writes the outcome of a word list action
+func respondWordListChange(context *gin.Context, result models.WordListChangeResult, err error) {
+	var wordSizeError *data.WordSizeError
+	var languageError *data.LanguageError
+	if errors.As(err, &wordSizeError) || errors.As(err, &languageError) {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if errors.Is(err, models.ErrNoAnswersLeft) {
+		context.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to change word list: " + err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, result)
+}
+
+// getWordList handles GET /admin/wordlists/:wordSize?language=en - Words in use and runtime overrides
+func getWordList(context *gin.Context) {
+	fmt.Println("Getting word list")
+
+	language, wordSize, ok := adminWordSize(context)
+	if !ok {
+		return
+	}
+
+	overrides, err := models.GetWordListOverrides(language, wordSize)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get word list overrides: " + err.Error()})
+		return
+	}
+
+	lists := data.Lists()
+	context.JSON(http.StatusOK, gin.H{
+		"language": language,
+		"wordSize": wordSize,
+		"answers": lists.Answers(language, wordSize).Words(),
+		"guesses": lists.Guesses(language, wordSize).Words(),
+		"overrides": overrides,
+	})
+}
+
+// addWords handles POST /admin/wordlists/:wordSize/words?language=en - Adds words to the answers or guesses
+// Body: {"words": ["CRANE", ...], "list": "answers" | "guesses"}; list defaults to answers
+func addWords(context *gin.Context) {
+	fmt.Println("Adding words to word list")
+
+	language, wordSize, ok := adminWordSize(context)
+	if !ok {
+		return
+	}
+
+	var request struct {
+		Words []string `json:"words" binding:"required"`
+		List  string   `json:"list"`
+	}
+	if err := context.ShouldBindJSON(&request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+	action, err := addAction(request.List)
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := models.ApplyWordListAction(middlewares.GetUserID(context), language, wordSize, action, request.Words)
+	respondWordListChange(context, result, err)
+}
+
+// importWords handles POST /admin/wordlists/:wordSize/import?list=answers|guesses&language=en - Bulk import
+// Body: plain text in the list file format, one word per line, # comments allowed
+func importWords(context *gin.Context) {
+	fmt.Println("Importing words into word list")
+
+	language, wordSize, ok := adminWordSize(context)
+	if !ok {
+		return
+	}
+	action, err := addAction(context.Query("list"))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(context.Request.Body, maxImportBytes+1))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body: " + err.Error()})
+		return
+	}
+	if len(body) > maxImportBytes {
+		context.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("Import must be at most %d bytes", maxImportBytes)})
+		return
+	}
+
+	words := []string{}
+	for _, line := range strings.Split(string(body), "\n") {
+		if !data.IsBlankListLine(line) {
+			words = append(words, line)
+		}
+	}
+	if len(words) == 0 {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Import has no words"})
+		return
+	}
+
+	result, err := models.ApplyWordListAction(middlewares.GetUserID(context), language, wordSize, action, words)
+	respondWordListChange(context, result, err)
+}
+
+// removeWord handles DELETE /admin/wordlists/:wordSize/words/:word?language=en - Retires or bans a word
+// ?list=answers retires the word: never picked again, still a valid guess
+// Otherwise the word is banned from both lists
+func removeWord(context *gin.Context) {
+	fmt.Println("Removing word from word list")
+
+	language, wordSize, ok := adminWordSize(context)
+	if !ok {
+		return
+	}
+
+	action := models.WordActionBan
+	switch context.Query("list") {
+	case "answers":
+		action = models.WordActionRetire
+	case "", "guesses":
+	default:
+		context.JSON(http.StatusBadRequest, gin.H{"error": "list must be answers or guesses"})
+		return
+	}
+
+	result, err := models.ApplyWordListAction(middlewares.GetUserID(context), language, wordSize, action, []string{context.Param("word")})
+	respondWordListChange(context, result, err)
+}
+
+// getWordListChanges handles GET /admin/wordlist-changes - Audit trail, newest first
+// Query: language, wordSize, cursor, limit (default 50, at most 200)
+func getWordListChanges(context *gin.Context) {
+	fmt.Println("Getting word list changes")
+
+	language := context.Query("language")
+	if language != "" {
+		if _, err := data.GetLanguage(language); err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	wordSize, err := queryInt(context, "wordSize")
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, err := queryInt(context, "limit")
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if limit == 0 {
+		limit = 50
+	}
+	if limit < 1 || limit > 200 {
+		context.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("limit must be between 1 and 200, got %d", limit)})
+		return
+	}
+	beforeID, err := decodeCursor(context.Query("cursor"))
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	changes, nextID, err := models.GetWordListChanges(language, wordSize, beforeID, limit)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get word list changes: " + err.Error()})
+		return
+	}
+
+	response := gin.H{
+		"items": changes,
+		"nextCursor": nil,
+	}
+	if nextID != 0 {
+		response["nextCursor"] = encodeCursor(nextID)
+	}
+
+	context.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,136 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"wordle-backend/middlewares"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type credentialsRequest struct {
+	Username string `json:"username" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// register handles POST /auth/register - Creates an account and logs it in
+func register(context *gin.Context) {
+	fmt.Println("Registering user")
+	
+	var request credentialsRequest
+	if err := context.ShouldBindJSON(&request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+	
+	user, err := models.RegisterUser(request.Username, request.Password)
+	if errors.Is(err, models.ErrUsernameTaken) {
+		context.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Failed to register: " + err.Error()})
+		return
+	}
+	
+	token, err := models.CreateSession(user.ID)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create session: " + err.Error()})
+		return
+	}
+	
+	context.JSON(http.StatusCreated, gin.H{
+		"message": "User registered successfully",
+		"user": user,
+		"token": token,
+	})
+}
+
+// login handles POST /auth/login - Exchanges credentials for a bearer token
+func login(context *gin.Context) {
+	fmt.Println("Logging in user")
+	
+	var request credentialsRequest
+	if err := context.ShouldBindJSON(&request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+	
+	user, err := models.AuthenticateUser(request.Username, request.Password)
+	if errors.Is(err, models.ErrInvalidCredentials) {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log in: " + err.Error()})
+		return
+	}
+	
+	token, err := models.CreateSession(user.ID)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create session: " + err.Error()})
+		return
+	}
+	
+	context.JSON(http.StatusOK, gin.H{
+		"message": "Logged in successfully",
+		"user": user,
+		"token": token,
+	})
+}
+
+// logout handles POST /auth/logout - Revokes the token used for the request
+func logout(context *gin.Context) {
+	fmt.Println("Logging out user")
+	
+	if err := models.DeleteSession(middlewares.BearerToken(context)); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to log out: " + err.Error()})
+		return
+	}
+	
+	context.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
+}
+
+// getCurrentUser handles GET /auth/me - Returns the account behind the token
+func getCurrentUser(context *gin.Context) {
+	user, err := models.GetUserByID(middlewares.GetUserID(context))
+	if err != nil {
+		context.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	
+	context.JSON(http.StatusOK, user)
+}
+
+// claimGuestGames handles POST /auth/claim - Moves a guest's games into the signed-in account
+func claimGuestGames(context *gin.Context) {
+	fmt.Println("Claiming guest games")
+	
+	var request struct {
+		GuestToken string `json:"guestToken" binding:"required"`
+	}
+	if err := context.ShouldBindJSON(&request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+	
+	result, err := models.ClaimGuestGames(middlewares.GetUserID(context), request.GuestToken)
+	if errors.Is(err, models.ErrInvalidSession) || errors.Is(err, models.ErrNotGuest) || errors.Is(err, models.ErrGuestCannotClaim) {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Failed to claim guest games: " + err.Error()})
+		return
+	}
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to claim guest games: " + err.Error()})
+		return
+	}
+	
+	context.JSON(http.StatusOK, gin.H{
+		"message": "Guest games claimed successfully",
+		"claimedGames": result.ClaimedGames,
+		"skippedDailyGames": result.SkippedDailyGames,
+		"skippedChallengeGames": result.SkippedChallengeGames,
+		"skippedRaces": result.SkippedRaces,
+	})
+}
@@ -0,0 +1,87 @@
+// Challenge Routes - Share a word of your choice with other players
+//
+// SECURITY: No endpoint returns a challenge's word; players start a game from
+// the code with POST /gamestates {"mode": "challenge", "challenge": code}
+package routes
+
+import (
+	"errors"
+	"net/http"
+	"wordle-backend/data"
+	"wordle-backend/middlewares"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// createChallenge handles POST /challenges - Creates a challenge and returns its code
+// Body: {"word": "CRANE", "language": "en", "maxTries": 6, "hardMode": false}
+func createChallenge(context *gin.Context) {
+	var request struct {
+		Word     string `json:"word" binding:"required"`
+		Language string `json:"language"`
+		MaxTries int    `json:"maxTries"`
+		HardMode bool   `json:"hardMode"`
+	}
+	request.Language = data.DefaultLanguage
+	request.MaxTries = 6
+	if err := context.ShouldBindJSON(&request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	challenge, err := models.CreateChallenge(models.ChallengeOptions{
+		Word:      request.Word,
+		Language:  request.Language,
+		MaxTries:  request.MaxTries,
+		HardMode:  request.HardMode,
+		CreatorID: middlewares.GetUserID(context),
+	})
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Failed to create challenge: " + err.Error()})
+		return
+	}
+
+	if err := models.SaveChallenge(&challenge); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save challenge: " + err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusCreated, challenge)
+}
+
+// getChallenge handles GET /challenges/:code - Settings of a challenge, without its word
+func getChallenge(context *gin.Context) {
+	challenge, err := models.GetChallengeByCode(context.Param("code"))
+	if err != nil {
+		respondGameError(context, "get challenge", err)
+		return
+	}
+
+	context.JSON(http.StatusOK, challenge)
+}
+
+// getChallengeResults handles GET /challenges/:code/results - Every game played on
+// a challenge, oldest first; only for the challenge's creator
+func getChallengeResults(context *gin.Context) {
+	challenge, err := models.GetChallengeByCode(context.Param("code"))
+	if err != nil {
+		respondGameError(context, "get challenge", err)
+		return
+	}
+
+	results, err := models.GetChallengeResults(challenge, middlewares.GetUserID(context))
+	if errors.Is(err, models.ErrNotChallengeCreator) {
+		context.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get challenge results: " + err.Error()})
+		return
+	}
+
+	context.JSON(http.StatusOK, gin.H{
+		"challenge": challenge,
+		"results": results,
+	})
+}
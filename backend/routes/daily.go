@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"wordle-backend/data"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getDailyPuzzle handles GET /daily/:date - Metadata for a daily puzzle
+// SECURITY: Never includes the word itself; "today" resolves to the current UTC date
+func getDailyPuzzle(context *gin.Context) {
+	fmt.Println("Getting daily puzzle")
+	
+	dateParam := context.Param("date")
+	if dateParam == "today" {
+		dateParam = models.FormatDailyDate(time.Now())
+	}
+	
+	date, err := models.ParseDailyDate(dateParam)
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid daily puzzle date: " + err.Error()})
+		return
+	}
+	
+	dailyDate := models.FormatDailyDate(date)
+	results, err := models.GetDailyResults(dailyDate)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get daily results: " + err.Error()})
+		return
+	}
+	
+	// Word sizes each language has lists for; English covers every size
+	languages := gin.H{}
+	for _, language := range data.Languages {
+		languages[language.Code] = data.LanguageWordSizes(language.Code)
+	}
+	
+	context.JSON(http.StatusOK, gin.H{
+		"date": dailyDate,
+		"puzzleNumber": models.DailyPuzzleNumber(date),
+		"playable": dailyDate == models.FormatDailyDate(time.Now()),
+		"wordSizes": data.WordSizes,
+		"languages": languages,
+		"results": results,
+	})
+}
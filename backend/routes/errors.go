@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gameErrorStatus maps typed domain errors from the models package onto HTTP status codes
+// Anything unrecognised is treated as an internal failure
+func gameErrorStatus(err error) int {
+	var transitionError *models.StatusTransitionError
+	var gameOverError *models.GameOverError
+	var guessLengthError *models.GuessLengthError
+	var hardModeViolation *models.HardModeViolation
+	
+	switch {
+	case errors.Is(err, models.ErrGameStateNotFound),
+		errors.Is(err, models.ErrChallengeNotFound),
+		errors.Is(err, models.ErrRaceNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, models.ErrOwnChallenge),
+		errors.Is(err, models.ErrNotChallengeCreator),
+		errors.Is(err, models.ErrNotRaceHost),
+		errors.Is(err, models.ErrNotRacePlayer):
+		return http.StatusForbidden
+	case errors.Is(err, models.ErrStaleGameState),
+		errors.Is(err, models.ErrChallengeAlreadyPlayed),
+		errors.Is(err, models.ErrRaceFull),
+		errors.Is(err, models.ErrRaceAlreadyStarted),
+		errors.Is(err, models.ErrRaceNotStarted),
+		errors.Is(err, models.ErrRaceFinished),
+		errors.Is(err, models.ErrRaceTimeUp),
+		errors.Is(err, models.ErrRaceNeedsPlayers),
+		errors.As(err, &transitionError),
+		errors.As(err, &gameOverError):
+		return http.StatusConflict
+	case errors.As(err, &guessLengthError),
+		errors.As(err, &hardModeViolation):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondGameError writes a domain error with its mapped status code
+// Internal errors keep the action prefix so logs and clients see what failed
+func respondGameError(context *gin.Context, action string, err error) {
+	status := gameErrorStatus(err)
+	if status == http.StatusInternalServerError {
+		context.JSON(status, gin.H{"error": "Failed to " + action + ": " + err.Error()})
+		return
+	}
+	context.JSON(status, gin.H{"error": err.Error()})
+}
@@ -18,10 +18,14 @@
 package routes
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
+	"wordle-backend/data"
 	"wordle-backend/helpers"
+	"wordle-backend/middlewares"
 	"wordle-backend/models"
 
 	"github.com/gin-gonic/gin"
@@ -34,13 +38,24 @@ func createGameState(context *gin.Context) {
 	fmt.Println("Creating wordle")
 	
 	var request struct {
+		Mode     string `json:"mode"`
+		Language string `json:"language"`
+		Boards   int `json:"boards"`
+		Challenge string `json:"challenge"` // Challenge code, for mode "challenge"
+		Seed     *int64 `json:"seed"`      // Optional, replays the words of an earlier speed game
 		MaxTries int `json:"maxTries"`
 		WordSize int `json:"wordSize"`
+		TimeLimit int `json:"timeLimit"`
+		HardMode bool `json:"hardMode"`
 	}
 	
 	// Default game configuration for optimal gameplay experience
-	request.MaxTries = 6  // Standard Wordle configuration
+	request.Mode = "speed" // API-based competitive mode
+	request.Language = data.DefaultLanguage // English lists cover every word size
+	request.Boards = 1    // Multi-board games play 2, 4 or 8 words at once
+	request.MaxTries = 6  // Standard Wordle configuration, per board
 	request.WordSize = 5  // Most common word length
+	request.TimeLimit = 45 // Speed mode seconds per guess
 	
 	if context.Request.ContentLength > 0 {
 		if err := context.ShouldBindJSON(&request); err != nil {
@@ -48,43 +63,124 @@ func createGameState(context *gin.Context) {
 		}
 	}
 	
-	gameState, err := models.CreateGameState(request.MaxTries, request.WordSize)
+	userID := middlewares.GetUserID(context)
+	
+	// Challenge games take their word and settings from the challenge
+	var challenge *models.Challenge
+	if request.Mode == "challenge" {
+		found, err := models.GetChallengeByCode(request.Challenge)
+		if err != nil {
+			respondGameError(context, "get challenge", err)
+			return
+		}
+		challenge = &found
+	}
+	
+	gameState, err := models.CreateGameState(models.GameOptions{
+		Mode:      request.Mode,
+		Language:  request.Language,
+		Boards:    request.Boards,
+		MaxTries:  request.MaxTries,
+		WordSize:  request.WordSize,
+		TimeLimit: request.TimeLimit,
+		UserID:    userID,
+		HardMode:  request.HardMode,
+		Challenge: challenge,
+		Seed:      request.Seed,
+	})
+	if errors.Is(err, models.ErrDailyRequiresPlayer) || errors.Is(err, models.ErrChallengeRequiresPlayer) {
+		context.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to create game state: " + err.Error()})
+		return
+	}
+	if errors.Is(err, models.ErrOwnChallenge) {
+		context.JSON(http.StatusForbidden, gin.H{"error": "Failed to create game state: " + err.Error()})
+		return
+	}
 	if err != nil {
 		context.JSON(http.StatusBadRequest, gin.H{"error": "Failed to create game state: " + err.Error()})
 		return
 	}
 	
-	err = models.SaveGameState(gameState)
+	// Anonymous players get a guest account so their games have an owner
+	// The guest token is returned once and must be sent as a Bearer token afterwards
+	// Daily and challenge games were rejected above without a token, since a fresh
+	// guest per request would get around their one-game limits
+	guestToken := ""
+	if userID == 0 {
+		guestToken, err = models.SaveGuestGameState(&gameState)
+	} else {
+		err = models.SaveGameState(&gameState)
+	}
+	if errors.Is(err, models.ErrDailyAlreadyPlayed) || errors.Is(err, models.ErrChallengeAlreadyPlayed) {
+		context.JSON(http.StatusConflict, gin.H{"error": "Failed to create game state: " + err.Error()})
+		return
+	}
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save game state: " + err.Error()})
 		return
 	}
 	
-	fmt.Printf("Game state created with ID: %d, Target Word: %s\n", gameState.ID, gameState.TargetWord)
+	fmt.Printf("Game state created with ID: %d\n", gameState.ID)
 	
-	context.JSON(http.StatusCreated, gin.H{
-		"message": "Game state created successfully",
-		"id": gameState.ID,
-		"tries": gameState.Tries,
-		"gameStatus": gameState.GameStatus,
-		"mode": gameState.Mode,
-		"maxTries": gameState.MaxTries,
-		"wordSize": gameState.WordSize,
-		"updatedAt": gameState.UpdatedAt,
-		"createdAt": gameState.CreatedAt,
-	})
+	response := newGameStateResponse(gameState, "Game state created successfully", middlewares.GetUserID(context))
+	response.GuestToken = guestToken
+	context.JSON(http.StatusCreated, response)
 }
 
+// getAllGameStates handles GET /gamestates - Lists game states one page at a time
+// Query: gameStatus, mode, wordSize, createdAfter, createdBefore (RFC 3339), order, cursor, limit
+// PAGINATION: Pass nextCursor from the previous response as cursor to get the next page
 func getAllGameStates(context *gin.Context) {
 	fmt.Println("Getting all game states")
 	
-	gameStates, err := models.GetAllGameStates()
+	filter := models.GameStateFilter{
+		GameStatus: context.Query("gameStatus"),
+		Mode:       context.Query("mode"),
+		Order:      context.Query("order"),
+	}
+	
+	var err error
+	if filter.WordSize, err = queryInt(context, "wordSize"); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.Limit, err = queryInt(context, "limit"); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.CreatedAfter, err = queryTime(context, "createdAfter"); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.CreatedBefore, err = queryTime(context, "createdBefore"); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filter.AfterID, err = decodeCursor(context.Query("cursor")); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	
+	if err := filter.Validate(); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid game state filter: " + err.Error()})
+		return
+	}
+	
+	gameStates, nextID, err := models.ListGameStates(filter)
 	if err != nil {
 		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get game states: " + err.Error()})
 		return
 	}
 	
-	context.JSON(http.StatusOK, gameStates)
+	response := gin.H{
+		"items": newGameStateResponses(gameStates, middlewares.GetUserID(context)),
+		"nextCursor": nil,
+	}
+	if nextID != 0 {
+		response["nextCursor"] = encodeCursor(nextID)
+	}
+	
+	context.JSON(http.StatusOK, response)
 }
 
 func getGameStateByID(context *gin.Context) {
@@ -96,13 +192,39 @@ func getGameStateByID(context *gin.Context) {
 		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid game state ID"})
 		return
 	}
-	gameState, err := models.GetGameStateByID(gameStateID)
+	gameState, err := loadGameState(gameStateID)
 	if err != nil {
-		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get game state by ID: " + err.Error()})
+		respondGameError(context, "get game state by ID", err)
 		return
 	}
 	
-	context.JSON(http.StatusOK, gameState)
+	context.JSON(http.StatusOK, newGameStateResponse(gameState, "", middlewares.GetUserID(context)))
+}
+
+// loadGameState is models.GetGameStateByID, first timing out a game whose guess
+// deadline passed without anyone noticing
+func loadGameState(gameStateID int64) (models.GameState, error) {
+	gameState, err := models.GetGameStateByID(gameStateID)
+	if err != nil || gameState.GameStatus != models.StatusPlaying || !gameState.IsPastGuessDeadline(time.Now()) {
+		return gameState, err
+	}
+	if err := gameState.TimeoutGameState(); err != nil {
+		if !errors.Is(err, models.ErrStaleGameState) {
+			return gameState, err
+		}
+		return models.GetGameStateByID(gameStateID)
+	}
+	return gameState, nil
+}
+
+// authorizeGameState rejects requests from anyone but the game's owner
+// SECURITY: Returns false after writing a 403 so handlers can simply return
+func authorizeGameState(context *gin.Context, gameState models.GameState) bool {
+	if gameState.IsOwnedBy(middlewares.GetUserID(context)) {
+		return true
+	}
+	context.JSON(http.StatusForbidden, gin.H{"error": "Game state belongs to another player"})
+	return false
 }
 
 // playGameState handles PUT /gamestates - Processes a guess in an active game
@@ -113,6 +235,7 @@ func playGameState(context *gin.Context) {
 	var updateRequest struct {
 		ID        int64  `json:"id"`
 		GuessWord string `json:"guessWord"`
+		Version   *int   `json:"version"` // Optional, version of the game the guess was made against
 	}
 	
 	if err := context.ShouldBindJSON(&updateRequest); err != nil {
@@ -132,14 +255,51 @@ func playGameState(context *gin.Context) {
 	
 	existingGameState, err := models.GetGameStateByID(updateRequest.ID)
 	if err != nil {
-		context.JSON(http.StatusNotFound, gin.H{"error": "Game state not found"})
+		respondGameError(context, "get game state", err)
+		return
+	}
+	if !authorizeGameState(context, existingGameState) {
+		return
+	}
+	
+	// Speed mode clock is authoritative on the server
+	// BUSINESS RULE: A late guess ends the game even if the client never reported the timeout
+	now := time.Now()
+	if existingGameState.GameStatus == models.StatusPlaying && existingGameState.IsPastGuessDeadline(now) {
+		fmt.Printf("Guess deadline passed for game state ID: %d\n", existingGameState.ID)
+		if err := existingGameState.TimeoutGameState(); err != nil {
+			respondGameError(context, "set game state status to timeout", err)
+			return
+		}
+		context.JSON(http.StatusConflict, gin.H{
+			"error": "Guess deadline has passed",
+			"gameState": newGameStateResponse(existingGameState, "", middlewares.GetUserID(context)),
+		})
+		return
+	}
+	
+	// State machine: finished games accept no further guesses
+	if existingGameState.IsFinished() {
+		context.JSON(http.StatusConflict, gin.H{
+			"error": (&models.GameOverError{Status: existingGameState.GameStatus}).Error(),
+			"gameState": newGameStateResponse(existingGameState, "", middlewares.GetUserID(context)),
+		})
+		return
+	}
+	
+	// Guesses are matched in the word list spelling of the game's language,
+	// so "árbol" and "ARBOL" are the same Spanish guess
+	guessWord := existingGameState.NormalizeGuess(updateRequest.GuessWord)
+	if err := existingGameState.CheckGuessLength(guessWord); err != nil {
+		respondGameError(context, "check guess length", err)
 		return
 	}
 
 	// Word validation against curated word lists
-	// BUSINESS RULE: Only valid words from approved lists are accepted
-	fmt.Printf("Validating word: %s\n", updateRequest.GuessWord)
-	if !helpers.IsWordInList(updateRequest.GuessWord) {
+	// BUSINESS RULE: Only valid words from approved lists are accepted; the game's own
+	// target always is, so banning a word at runtime cannot make running games unwinnable
+	fmt.Printf("Validating word: %s\n", guessWord)
+	if !helpers.IsWordInList(existingGameState.Language, guessWord) && !existingGameState.IsTargetWord(guessWord) {
 		fmt.Printf("Word validation failed for: %s\n", updateRequest.GuessWord)
 		// Return specific error with invalid word for frontend handling
 		context.JSON(http.StatusBadRequest, gin.H{
@@ -148,55 +308,33 @@ func playGameState(context *gin.Context) {
 		})
 		return
 	}
-	fmt.Printf("Word validation passed for: %s\n", updateRequest.GuessWord)
-	
-	letterResultArray := models.ValidateGuess(updateRequest.GuessWord, existingGameState.TargetWord)
-	
-	validatedGuess := models.GuessResult{
-		GuessWord: updateRequest.GuessWord,
-		LetterResultArray:   letterResultArray,
-		IsCorrect: updateRequest.GuessWord == existingGameState.TargetWord,
-	}
-	
-	newGameStatus := existingGameState.DetermineGameStatus(validatedGuess.IsCorrect)
+	fmt.Printf("Word validation passed for: %s\n", guessWord)
 	
-	updateGameState := models.GameState{
-		ID:         updateRequest.ID,
-		Tries:      []models.GuessResult{validatedGuess},
-		GameStatus: newGameStatus,
+	// Optimistic locking: clients may send the version they last saw,
+	// otherwise the version read above is used for the compare-and-swap
+	expectedVersion := existingGameState.Version
+	if updateRequest.Version != nil {
+		expectedVersion = *updateRequest.Version
 	}
 	
-	err = models.UpdateGameState(updateGameState)
+	updatedGameState, err := models.PlayGuess(updateRequest.ID, expectedVersion, guessWord, now)
 	if err != nil {
-		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update game state: " + err.Error()})
-		return
-	}
-	
-	updatedGameState, err := models.GetGameStateByID(updateRequest.ID)
-	if err != nil {
-		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated game state: " + err.Error()})
+		// Hard mode: revealed hints from earlier tries must be reused
+		var violation *models.HardModeViolation
+		if errors.As(err, &violation) {
+			context.JSON(http.StatusBadRequest, gin.H{
+				"error": "Hard mode: " + violation.Error(),
+				"hardModeViolation": violation,
+			})
+			return
+		}
+		respondGameError(context, "update game state", err)
 		return
 	}
 	
 	fmt.Printf("Game state updated with ID: %d\n", updatedGameState.ID)
 	
-	response := gin.H{
-		"message": "Game state updated successfully",
-		"id": updatedGameState.ID,
-		"tries": updatedGameState.Tries,
-		"gameStatus": updatedGameState.GameStatus,
-		"mode": updatedGameState.Mode,
-		"maxTries": updatedGameState.MaxTries,
-		"wordSize": updatedGameState.WordSize,
-		"updatedAt": updatedGameState.UpdatedAt,
-		"createdAt": updatedGameState.CreatedAt,
-	}
-	
-	if updatedGameState.GameStatus == "won" || updatedGameState.GameStatus == "lost" {
-		response["targetWord"] = updatedGameState.TargetWord
-	}
-	
-	context.JSON(http.StatusOK, response)
+	context.JSON(http.StatusOK, newGameStateResponse(updatedGameState, "Game state updated successfully", middlewares.GetUserID(context)))
 }
 
 func leaveGameStateByID(context *gin.Context) {
@@ -208,23 +346,26 @@ func leaveGameStateByID(context *gin.Context) {
 		return
 	}
 	
-	gameState, err := models.GetGameStateByID(gameStateID)
+	gameState, err := loadGameState(gameStateID)
 	if err != nil {
-		context.JSON(http.StatusNotFound, gin.H{"error": "Game state not found"})
+		respondGameError(context, "get game state", err)
+		return
+	}
+	if !authorizeGameState(context, gameState) {
 		return
 	}
 	
 	err = gameState.LeaveGameState()
 	if err != nil {
-		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to leave game state: " + err.Error()})
+		respondGameError(context, "leave game state", err)
 		return
 	}
 	
-	context.JSON(http.StatusOK, gin.H{"message": "Player left the game state successfully"})
+	context.JSON(http.StatusOK, newGameStateResponse(gameState, "Player left the game state successfully", middlewares.GetUserID(context)))
 }
 
 func timeoutGameStateByID(context *gin.Context) {
-	fmt.Println("Setting game state status to lose")
+	fmt.Println("Setting game state status to timeout")
 	
 	gameStateID, err := strconv.ParseInt(context.Param("id"), 10, 64)
 	if err != nil {
@@ -232,33 +373,20 @@ func timeoutGameStateByID(context *gin.Context) {
 		return
 	}
 	
-	gameState, err := models.GetGameStateByID(gameStateID)
+	gameState, err := loadGameState(gameStateID)
 	if err != nil {
-		context.JSON(http.StatusNotFound, gin.H{"error": "Game state not found"})
+		respondGameError(context, "get game state", err)
 		return
 	}
-	
-	err = gameState.TimeoutGameState()
-	if err != nil {
-		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set game state status to timeout: " + err.Error()})
+	if !authorizeGameState(context, gameState) {
 		return
 	}
 	
-	updatedGameState, err := models.GetGameStateByID(gameStateID)
+	err = gameState.TimeoutGameState()
 	if err != nil {
-		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated game state: " + err.Error()})
+		respondGameError(context, "set game state status to timeout", err)
 		return
 	}
 	
-	context.JSON(http.StatusOK, gin.H{
-		"message": "Game state status set to lost successfully",
-		"id": updatedGameState.ID,
-		"targetWord": updatedGameState.TargetWord,
-		"gameStatus": updatedGameState.GameStatus,
-		"mode": updatedGameState.Mode,
-		"maxTries": updatedGameState.MaxTries,
-		"wordSize": updatedGameState.WordSize,
-		"updatedAt": updatedGameState.UpdatedAt,
-		"createdAt": updatedGameState.CreatedAt,
-	})
+	context.JSON(http.StatusOK, newGameStateResponse(gameState, "Game state status set to timeout successfully", middlewares.GetUserID(context)))
 }
\ No newline at end of file
@@ -0,0 +1,54 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getLeaderboard handles GET /leaderboards - Ranked players with pagination
+// Query: period, mode, wordSize, maxTries, sort, page, pageSize (all optional)
+func getLeaderboard(context *gin.Context) {
+	fmt.Println("Getting leaderboard")
+	
+	filter := models.LeaderboardFilter{
+		Period: context.Query("period"),
+		Mode:   context.Query("mode"),
+		SortBy: context.Query("sort"),
+	}
+	
+	var err error
+	for name, target := range map[string]*int{
+		"wordSize": &filter.WordSize,
+		"maxTries": &filter.MaxTries,
+		"page":     &filter.Page,
+		"pageSize": &filter.PageSize,
+	} {
+		if *target, err = queryInt(context, name); err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	
+	if err := filter.Validate(); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid leaderboard filter: " + err.Error()})
+		return
+	}
+	
+	entries, total, err := models.GetLeaderboard(filter)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get leaderboard: " + err.Error()})
+		return
+	}
+	
+	context.JSON(http.StatusOK, gin.H{
+		"period": filter.Period,
+		"sort": filter.SortBy,
+		"page": filter.Page,
+		"pageSize": filter.PageSize,
+		"total": total,
+		"entries": entries,
+	})
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"wordle-backend/middlewares"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getPlayerStats handles GET /players/:id/stats - Win rate, streaks and guess distribution
+// "me" resolves to the authenticated player
+func getPlayerStats(context *gin.Context) {
+	fmt.Println("Getting player stats")
+	
+	var userID int64
+	if context.Param("id") == "me" {
+		userID = middlewares.GetUserID(context)
+		if userID == 0 {
+			context.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+	} else {
+		var err error
+		userID, err = strconv.ParseInt(context.Param("id"), 10, 64)
+		if err != nil {
+			context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid player ID"})
+			return
+		}
+	}
+	
+	if _, err := models.GetUserByID(userID); err != nil {
+		context.JSON(http.StatusNotFound, gin.H{"error": "Player not found"})
+		return
+	}
+	
+	stats, err := models.GetPlayerStats(userID)
+	if err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get player stats: " + err.Error()})
+		return
+	}
+	
+	context.JSON(http.StatusOK, stats)
+}
@@ -0,0 +1,66 @@
+package routes
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// queryInt parses an optional integer query parameter, returning 0 when absent
+func queryInt(context *gin.Context, name string) (int, error) {
+	value := context.Query(name)
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", name, value)
+	}
+	return parsed, nil
+}
+
+// queryTime parses an optional RFC 3339 query parameter, returning the zero time when absent
+func queryTime(context *gin.Context, name string) (time.Time, error) {
+	value := context.Query(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %s must be an RFC 3339 timestamp", name, value)
+	}
+	return parsed, nil
+}
+
+// cursorPrefix versions the cursor format so it can change without breaking old links
+const cursorPrefix = "id:"
+
+// encodeCursor wraps a row id in an opaque pagination cursor
+// DESIGN DECISION: Opaque to clients so the keyset can change without breaking the API
+func encodeCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.FormatInt(id, 10)))
+}
+
+// decodeCursor reverses encodeCursor, returning 0 for an empty cursor
+func decodeCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	idText, found := strings.CutPrefix(string(decoded), cursorPrefix)
+	if !found {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	id, err := strconv.ParseInt(idText, 10, 64)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+	return id, nil
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, id := range []int64{1, 42, 1 << 40} {
+		decoded, err := decodeCursor(encodeCursor(id))
+		if err != nil || decoded != id {
+			t.Errorf("decodeCursor(encodeCursor(%d)) = %d, %v", id, decoded, err)
+		}
+	}
+
+	if id, err := decodeCursor(""); err != nil || id != 0 {
+		t.Errorf("decodeCursor(\"\") = %d, %v, want 0, nil", id, err)
+	}
+}
+
+func TestDecodeCursorRejectsInvalid(t *testing.T) {
+	encode := func(text string) string { return base64.RawURLEncoding.EncodeToString([]byte(text)) }
+	for _, cursor := range []string{"not base64!", encode("42"), encode("id:"), encode("id:abc"), encode("id:0"), encode("id:-5")} {
+		if id, err := decodeCursor(cursor); err == nil {
+			t.Errorf("decodeCursor(%q) = %d, want an error", cursor, id)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Race Hub - Fans race events out to the WebSocket connections of each race
+//
+// ARCHITECTURE DECISION: One in-process hub, connections grouped by race
+// - Every connection gets a buffered outbox drained by its own writer goroutine,
+//   so a slow client never holds up a guess or the other players
+// - A client whose outbox is full is dropped; on reconnect it gets a fresh snapshot
+// - Events only announce what models already committed (see models/race.go), so a
+//   dropped connection loses nothing that GET /races/:code cannot show again
+// - A finished race is closed: its last event goes out and every connection is dropped
+//
+// TRADE-OFFS CONSIDERED:
+// - In-process vs Pub/Sub: All players of a race must reach the same server instance;
+//   a broker (e.g. PostgreSQL LISTEN/NOTIFY) would be needed to run several instances
+package routes
+
+import (
+	"sync"
+)
+
+// raceOutboxSize is how many events may queue for one connection before it is dropped
+const raceOutboxSize = 32
+
+// raceClient is one WebSocket connection to a race
+type raceClient struct {
+	userID int64
+	outbox chan any // Closed by the hub when the client is unsubscribed
+}
+
+// raceHub tracks the connected clients of every race
+type raceHub struct {
+	mutex   sync.Mutex
+	clients map[int64]map[*raceClient]bool
+}
+
+// liveRaces is the hub shared by every race connection of this server
+var liveRaces = &raceHub{clients: make(map[int64]map[*raceClient]bool)}
+
+// subscribe registers a new connection of userID to a race
+func (h *raceHub) subscribe(raceID int64, userID int64) *raceClient {
+	client := &raceClient{userID: userID, outbox: make(chan any, raceOutboxSize)}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.clients[raceID] == nil {
+		h.clients[raceID] = make(map[*raceClient]bool)
+	}
+	h.clients[raceID][client] = true
+	return client
+}
+
+// unsubscribe removes a connection and closes its outbox; calling it twice is harmless
+func (h *raceHub) unsubscribe(raceID int64, client *raceClient) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.remove(raceID, client)
+}
+
+// connected reports whether userID still has a connection open to a race
+func (h *raceHub) connected(raceID int64, userID int64) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for client := range h.clients[raceID] {
+		if client.userID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// closeRace drops every connection of a finished race; each writer still sends
+// what is queued in its outbox before closing its connection
+func (h *raceHub) closeRace(raceID int64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for client := range h.clients[raceID] {
+		h.remove(raceID, client)
+	}
+}
+
+// send queues an event for one connection
+func (h *raceHub) send(raceID int64, client *raceClient, event any) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.deliver(raceID, client, event)
+}
+
+// broadcast queues an event for every connection of a race except one, which may be nil
+func (h *raceHub) broadcast(raceID int64, event any, except *raceClient) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for client := range h.clients[raceID] {
+		if client != except {
+			h.deliver(raceID, client, event)
+		}
+	}
+}
+
+// deliver queues an event without blocking, dropping clients that fall behind
+// Callers hold h.mutex, so outboxes are never written after being closed
+func (h *raceHub) deliver(raceID int64, client *raceClient, event any) {
+	if !h.clients[raceID][client] {
+		return
+	}
+	select {
+	case client.outbox <- event:
+	default:
+		h.remove(raceID, client)
+	}
+}
+
+// remove drops a connection from a race; callers hold h.mutex
+func (h *raceHub) remove(raceID int64, client *raceClient) {
+	if !h.clients[raceID][client] {
+		return
+	}
+	delete(h.clients[raceID], client)
+	if len(h.clients[raceID]) == 0 {
+		delete(h.clients, raceID)
+	}
+	close(client.outbox)
+}
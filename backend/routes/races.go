@@ -0,0 +1,423 @@
+// Race Routes - Real-time multiplayer races over WebSockets
+//
+// ARCHITECTURE DECISION: REST for the lobby, a WebSocket for the race itself
+// - POST /races, /races/:code/join and /races/:code/start manage the room
+// - GET /races/:code/ws carries guesses in and race events out while racing
+// - GET /races/:code reviews a race at any time; it is also the snapshot a
+//   reconnecting client starts from
+//
+// PROTOCOL: JSON text frames
+// - Client: {"type": "auth", "token": "..."} first, unless an Authorization header was sent
+// - Client: {"type": "guess", "guessWord": "crane"}
+// - Server: "race" (snapshot on connect), "joined", "started" and "finished" carry the race
+// - Server: "guess" is the full result of your own guess, "progress" the pattern of an
+//   opponent's, e.g. {"type": "progress", "userId": 7, "pattern": "CAPAC", ...}
+// - Server: "forfeit" when a player left the race, e.g. {"type": "forfeit", "userId": 7}
+// - Server: "error" for a rejected message; the connection stays open
+// - The server closes every connection once the race is finished
+//
+// BUSINESS RULES:
+// - A player whose last connection closes has raceReconnectGrace to come back, so a
+//   reload does not cost the race; after that they forfeit and lose
+// - A timer started with the race finishes it when models.RaceTimeLimit is up; races
+//   that outlived their timer, e.g. across a restart, are finished when next loaded
+//
+// SECURITY:
+// - Browsers cannot set headers on a WebSocket, and query strings end up in access logs,
+//   so the token may be sent as the first frame instead
+// - Only players of the race may connect
+// - Any page can open a WebSocket to any site, so a handshake carrying an Origin header
+//   must come from one of RaceOrigins; clients without one are not browsers and
+//   authenticate by token alone
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"wordle-backend/data"
+	"wordle-backend/helpers"
+	"wordle-backend/middlewares"
+	"wordle-backend/models"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// raceAuthTimeout is how long a connection may take to send its auth frame
+	raceAuthTimeout = 10 * time.Second
+	// raceMaxMessageBytes caps client frames; a guess needs far less
+	raceMaxMessageBytes = 1024
+	// raceReconnectGrace is how long a player who lost their last connection may take to reconnect
+	raceReconnectGrace = 10 * time.Second
+)
+
+// RaceOrigins lists the browser origins allowed to open race WebSockets, e.g.
+// "http://localhost:3000"; main sets it from API_URL
+var RaceOrigins []string
+
+// raceMessage is a frame sent by a race client
+type raceMessage struct {
+	Type      string `json:"type"`
+	Token     string `json:"token"`
+	GuessWord string `json:"guessWord"`
+}
+
+// createRace handles POST /races - Creates a race room with the caller as host
+// Body: {"language": "en", "wordSize": 5, "maxTries": 6, "maxPlayers": 8}
+func createRace(context *gin.Context) {
+	fmt.Println("Creating race")
+
+	var request struct {
+		Language   string `json:"language"`
+		WordSize   int    `json:"wordSize"`
+		MaxTries   int    `json:"maxTries"`
+		MaxPlayers int    `json:"maxPlayers"`
+	}
+	request.Language = data.DefaultLanguage
+	request.WordSize = 5
+	request.MaxTries = 6
+	request.MaxPlayers = models.MaxRacePlayers
+	if err := context.ShouldBindJSON(&request); err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body: " + err.Error()})
+		return
+	}
+
+	userID := middlewares.GetUserID(context)
+	race, err := models.CreateRace(models.RaceOptions{
+		Language:   request.Language,
+		WordSize:   request.WordSize,
+		MaxTries:   request.MaxTries,
+		MaxPlayers: request.MaxPlayers,
+		HostID:     userID,
+	})
+	if err != nil {
+		context.JSON(http.StatusBadRequest, gin.H{"error": "Failed to create race: " + err.Error()})
+		return
+	}
+
+	if err := models.SaveRace(&race); err != nil {
+		context.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save race: " + err.Error()})
+		return
+	}
+
+	race, err = models.GetRaceByCode(race.Code)
+	if err != nil {
+		respondGameError(context, "get race", err)
+		return
+	}
+
+	fmt.Printf("Race created with code: %s\n", race.Code)
+
+	context.JSON(http.StatusCreated, newRaceResponse(race, nil, userID))
+}
+
+// getRace handles GET /races/:code - The race with its players and every guess so far
+func getRace(context *gin.Context) {
+	fmt.Println("Getting race")
+
+	race, err := loadRace(context.Param("code"))
+	if err != nil {
+		respondGameError(context, "get race", err)
+		return
+	}
+
+	guesses, err := models.GetRaceGuesses(race.ID)
+	if err != nil {
+		respondGameError(context, "get race guesses", err)
+		return
+	}
+
+	context.JSON(http.StatusOK, newRaceResponse(race, guesses, middlewares.GetUserID(context)))
+}
+
+// joinRace handles POST /races/:code/join - Adds the caller to a waiting race
+func joinRace(context *gin.Context) {
+	fmt.Println("Joining race")
+
+	userID := middlewares.GetUserID(context)
+	race, err := models.JoinRace(context.Param("code"), userID)
+	if err != nil {
+		respondGameError(context, "join race", err)
+		return
+	}
+
+	liveRaces.broadcast(race.ID, gin.H{"type": "joined", "race": newRaceResponse(race, nil, 0)}, nil)
+
+	context.JSON(http.StatusOK, newRaceResponse(race, nil, userID))
+}
+
+// startRace handles POST /races/:code/start - The host starts the race for everyone
+func startRace(context *gin.Context) {
+	fmt.Println("Starting race")
+
+	userID := middlewares.GetUserID(context)
+	race, err := models.StartRace(context.Param("code"), userID)
+	if err != nil {
+		respondGameError(context, "start race", err)
+		return
+	}
+
+	fmt.Printf("Race started with code: %s\n", race.Code)
+
+	liveRaces.broadcast(race.ID, gin.H{"type": "started", "race": newRaceResponse(race, nil, 0)}, nil)
+	time.AfterFunc(time.Until(race.Deadline()), func() { expireRace(race) })
+
+	context.JSON(http.StatusOK, newRaceResponse(race, nil, userID))
+}
+
+// raceSocket handles GET /races/:code/ws - Upgrades to the race's WebSocket
+func raceSocket(context *gin.Context) {
+	fmt.Println("Connecting to race")
+
+	race, err := loadRace(context.Param("code"))
+	if err != nil {
+		respondGameError(context, "get race", err)
+		return
+	}
+
+	// Clients that can set headers are already authenticated by the middleware
+	userID := middlewares.GetUserID(context)
+	if userID != 0 && !race.HasPlayer(userID) {
+		respondGameError(context, "connect to race", models.ErrNotRacePlayer)
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: checkRaceOrigin,
+		Handler: func(conn *websocket.Conn) {
+			serveRaceSocket(conn, race, userID)
+		},
+	}
+	server.ServeHTTP(context.Writer, context.Request)
+}
+
+// serveRaceSocket runs one race connection until the client goes away
+func serveRaceSocket(conn *websocket.Conn, race models.Race, userID int64) {
+	conn.MaxPayloadBytes = raceMaxMessageBytes
+
+	if userID == 0 {
+		var err error
+		userID, err = authenticateRaceSocket(conn)
+		if err != nil {
+			websocket.JSON.Send(conn, gin.H{"type": "error", "error": err.Error()})
+			return
+		}
+		// The race was loaded before the upgrade; the player may have joined since
+		if race, err = loadRace(race.Code); err != nil {
+			websocket.JSON.Send(conn, raceErrorEvent("get race", err))
+			return
+		}
+		if !race.HasPlayer(userID) {
+			websocket.JSON.Send(conn, raceErrorEvent("connect to race", models.ErrNotRacePlayer))
+			return
+		}
+	}
+
+	client := liveRaces.subscribe(race.ID, userID)
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		for event := range client.outbox {
+			if err := websocket.JSON.Send(conn, event); err != nil {
+				conn.Close() // Unblocks the read loop below
+				return
+			}
+		}
+		conn.Close()
+	}()
+	defer func() {
+		liveRaces.unsubscribe(race.ID, client)
+		<-written
+		time.AfterFunc(raceReconnectGrace, func() { forfeitRace(race, userID) })
+	}()
+
+	// Subscribed first, so nothing committed after this snapshot is missed
+	guesses, err := models.GetRaceGuesses(race.ID)
+	if err != nil {
+		liveRaces.send(race.ID, client, raceErrorEvent("get race guesses", err))
+		return
+	}
+	liveRaces.send(race.ID, client, gin.H{"type": "race", "race": newRaceResponse(race, guesses, userID)})
+	if race.Status == models.RaceFinished {
+		return
+	}
+
+	for {
+		var message raceMessage
+		if err := websocket.JSON.Receive(conn, &message); err != nil {
+			fmt.Printf("Race connection closed for user ID: %d\n", userID)
+			return
+		}
+
+		switch message.Type {
+		case "guess":
+			playRaceGuess(race, client, message.GuessWord)
+		default:
+			liveRaces.send(race.ID, client, gin.H{"type": "error", "error": "Unknown message type: " + message.Type})
+		}
+	}
+}
+
+// authenticateRaceSocket reads the auth frame and resolves its token to a user
+func authenticateRaceSocket(conn *websocket.Conn) (int64, error) {
+	conn.SetReadDeadline(time.Now().Add(raceAuthTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+
+	var message raceMessage
+	if err := websocket.JSON.Receive(conn, &message); err != nil || message.Type != "auth" || message.Token == "" {
+		return 0, fmt.Errorf("first message must be {\"type\": \"auth\", \"token\": \"...\"}")
+	}
+	return models.GetUserIDBySessionToken(message.Token)
+}
+
+// playRaceGuess validates and plays one guess, then tells the guesser the full result
+// and everyone else only its pattern
+func playRaceGuess(race models.Race, client *raceClient, guessWord string) {
+	fmt.Printf("Playing race guess for user ID: %d\n", client.userID)
+
+	// Same validation as playGameState: language spelling, length, then the word lists
+	normalizedGuess := race.NormalizeGuess(guessWord)
+	if err := race.CheckGuessLength(normalizedGuess); err != nil {
+		liveRaces.send(race.ID, client, raceErrorEvent("check guess length", err))
+		return
+	}
+	if !helpers.IsWordInList(race.Language, normalizedGuess) && normalizedGuess != race.TargetWord {
+		liveRaces.send(race.ID, client, gin.H{
+			"type": "error",
+			"error": "Invalid word: " + guessWord + " is not a valid word",
+			"invalid_guess_word": guessWord,
+		})
+		return
+	}
+
+	result, err := models.PlayRaceGuess(race.ID, client.userID, normalizedGuess, time.Now())
+	if err != nil {
+		liveRaces.send(race.ID, client, raceErrorEvent("play race guess", err))
+		if errors.Is(err, models.ErrRaceTimeUp) {
+			expireRace(race)
+		}
+		return
+	}
+
+	liveRaces.send(race.ID, client, gin.H{
+		"type": "guess",
+		"guessIndex": result.GuessIndex,
+		"guess": result.Guess,
+		"playerStatus": result.PlayerStatus,
+	})
+	liveRaces.broadcast(race.ID, gin.H{
+		"type": "progress",
+		"userId": client.userID,
+		"guessIndex": result.GuessIndex,
+		"pattern": result.Guess.Pattern(),
+		"isCorrect": result.Guess.IsCorrect,
+		"playerStatus": result.PlayerStatus,
+	}, client)
+
+	if result.RaceStatus != models.RaceFinished {
+		return
+	}
+
+	fmt.Printf("Race finished with code: %s, winner user ID: %d\n", race.Code, result.WinnerID)
+
+	if err := announceRaceFinished(race); err != nil {
+		liveRaces.send(race.ID, client, raceErrorEvent("get finished race", err))
+	}
+}
+
+// checkRaceOrigin is the WebSocket handshake check, see SECURITY above
+func checkRaceOrigin(config *websocket.Config, request *http.Request) error {
+	origin := request.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	if !slices.Contains(RaceOrigins, strings.TrimSuffix(origin, "/")) {
+		fmt.Printf("Race connection refused for origin: %s\n", origin)
+		return fmt.Errorf("origin %s is not allowed", origin)
+	}
+	return nil
+}
+
+// loadRace is models.GetRaceByCode, first finishing a race whose time ran out unnoticed
+func loadRace(code string) (models.Race, error) {
+	race, err := models.GetRaceByCode(code)
+	if err != nil || race.Status != models.RaceRacing || time.Now().Before(race.Deadline()) {
+		return race, err
+	}
+	expireRace(race)
+	return models.GetRaceByCode(code)
+}
+
+// expireRace finishes a race whose time is up and tells its players
+func expireRace(race models.Race) {
+	expired, err := models.ExpireRace(race.ID, time.Now())
+	if err != nil {
+		fmt.Printf("Error expiring race %s: %v\n", race.Code, err)
+		return
+	}
+	if !expired {
+		return
+	}
+
+	fmt.Printf("Race timed out with code: %s\n", race.Code)
+
+	if err := announceRaceFinished(race); err != nil {
+		fmt.Printf("Error announcing finished race %s: %v\n", race.Code, err)
+	}
+}
+
+// forfeitRace makes a player lose who left a running race and has not reconnected
+func forfeitRace(race models.Race, userID int64) {
+	if liveRaces.connected(race.ID, userID) {
+		return
+	}
+
+	forfeited, status, err := models.ForfeitRace(race.ID, userID, time.Now())
+	if err != nil {
+		fmt.Printf("Error forfeiting race %s for user ID %d: %v\n", race.Code, userID, err)
+		return
+	}
+	if !forfeited {
+		return
+	}
+
+	fmt.Printf("Race forfeited with code: %s, user ID: %d\n", race.Code, userID)
+
+	liveRaces.broadcast(race.ID, gin.H{"type": "forfeit", "userId": userID, "playerStatus": models.StatusLost}, nil)
+	if status != models.RaceFinished {
+		return
+	}
+	if err := announceRaceFinished(race); err != nil {
+		fmt.Printf("Error announcing finished race %s: %v\n", race.Code, err)
+	}
+}
+
+// announceRaceFinished sends every connection the finished race, then closes them
+// Finished races reveal everything, so every player gets the same review
+func announceRaceFinished(race models.Race) error {
+	finishedRace, err := models.GetRaceByCode(race.Code)
+	if err != nil {
+		return err
+	}
+	guesses, err := models.GetRaceGuesses(race.ID)
+	if err != nil {
+		return err
+	}
+
+	liveRaces.broadcast(race.ID, gin.H{"type": "finished", "race": newRaceResponse(finishedRace, guesses, 0)}, nil)
+	liveRaces.closeRace(race.ID)
+	return nil
+}
+
+// raceErrorEvent is the WebSocket counterpart of respondGameError
+func raceErrorEvent(action string, err error) gin.H {
+	if gameErrorStatus(err) == http.StatusInternalServerError {
+		return gin.H{"type": "error", "error": "Failed to " + action + ": " + err.Error()}
+	}
+	return gin.H{"type": "error", "error": err.Error()}
+}
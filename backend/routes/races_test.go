@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckRaceOrigin(t *testing.T) {
+	previous := RaceOrigins
+	RaceOrigins = []string{"http://localhost:3000"}
+	t.Cleanup(func() { RaceOrigins = previous })
+
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"", true}, // Not a browser
+		{"http://localhost:3000", true},
+		{"http://localhost:3000/", true},
+		{"https://evil.example", false},
+		{"http://localhost:3001", false},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest("GET", "/races/abc/ws", nil)
+		if test.origin != "" {
+			request.Header.Set("Origin", test.origin)
+		}
+		if err := checkRaceOrigin(nil, request); (err == nil) != test.allowed {
+			t.Errorf("checkRaceOrigin(%q) = %v, want allowed %v", test.origin, err, test.allowed)
+		}
+	}
+}
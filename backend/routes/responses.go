@@ -0,0 +1,227 @@
+// Public Response DTOs - What the API is allowed to reveal about a game
+//
+// ARCHITECTURE DECISION: Dedicated response types instead of serializing models directly
+// - models.GameState carries server-only fields such as the target word
+// - Every endpoint builds its payload through newGameStateResponse
+// - Adding a field to the model never leaks it until it is added here
+//
+// SECURITY CONSIDERATIONS:
+// - Target word is only revealed once the game is over (won, lost or timeout),
+//   and never for challenge games, whose word belongs to the challenge's creator
+// - A daily game's word is everyone's puzzle that day, so until its date has passed
+//   only the game's owner sees it; others see the colours of its tries but not their letters
+// - The seed gives the word away just as well, so it is revealed at the same time
+// - On multi-board games each board's target is revealed once that board is solved
+// - A race's word, and the letters of other players' guesses, are revealed once the race is finished
+package routes
+
+import (
+	"time"
+	"wordle-backend/models"
+)
+
+// GameStateResponse is the client-facing view of a game session
+type GameStateResponse struct {
+	Message       string               `json:"message,omitempty"`
+	ID            int64                `json:"id"`
+	TargetWord    string               `json:"targetWord,omitempty"` // Only set once the game has ended
+	Tries         []models.GuessResult `json:"tries"`
+	Boards        []BoardResponse      `json:"boards,omitempty"` // Only for multi-board games
+	GameStatus    string               `json:"gameStatus"`
+	Mode          string               `json:"mode"`
+	Language      string               `json:"language"`
+	MaxTries      int                  `json:"maxTries"`
+	WordSize      int                  `json:"wordSize"`
+	TimeLimit     int                  `json:"timeLimit"`
+	GuessDeadline *time.Time           `json:"guessDeadline,omitempty"` // Only set for timed games
+	UserID        int64                `json:"userId,omitempty"`
+	DailyDate     string               `json:"dailyDate,omitempty"`
+	HardMode      bool                 `json:"hardMode"`
+	Seed          *int64               `json:"seed,omitempty"`        // Only set once a speed game has ended
+	Seeded        bool                 `json:"seeded,omitempty"`      // The player chose the seed
+	ListVersion   string               `json:"listVersion,omitempty"` // Answer list the words were picked from
+	Version       int                  `json:"version"` // Send back with the next guess to detect conflicting writes
+	CreatedAt     time.Time            `json:"createdAt"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+	GuestToken    string               `json:"guestToken,omitempty"` // Only on the create response that issued it
+}
+
+// newGameStateResponse maps a game state onto its public representation as seen by viewerID
+func newGameStateResponse(gameState models.GameState, message string, viewerID int64) GameStateResponse {
+	response := GameStateResponse{
+		Message:       message,
+		ID:            gameState.ID,
+		Tries:         gameState.Tries,
+		GameStatus:    gameState.GameStatus,
+		Mode:          gameState.Mode,
+		Language:      gameState.Language,
+		MaxTries:      gameState.MaxTries,
+		WordSize:      gameState.WordSize,
+		TimeLimit:     gameState.TimeLimit,
+		UserID:        gameState.UserID,
+		DailyDate:     gameState.DailyDate,
+		HardMode:      gameState.HardMode,
+		Seeded:        gameState.Seeded,
+		ListVersion:   gameState.ListVersion,
+		Version:       gameState.Version,
+		CreatedAt:     gameState.CreatedAt,
+		UpdatedAt:     gameState.UpdatedAt,
+	}
+	if response.Tries == nil {
+		response.Tries = []models.GuessResult{}
+	}
+	
+	if !gameState.GuessDeadline.IsZero() {
+		response.GuessDeadline = &gameState.GuessDeadline
+	}
+	
+	revealed := revealsDailyPuzzle(gameState, viewerID, time.Now())
+	if gameState.IsFinished() && gameState.Mode != "challenge" && revealed {
+		response.TargetWord = gameState.TargetWord
+	}
+	if !revealed {
+		response.Tries = hideLetters(response.Tries)
+	}
+	// Speed games from before seeds were recorded have no list version either
+	if gameState.IsFinished() && gameState.Mode == "speed" && gameState.ListVersion != "" {
+		response.Seed = &gameState.Seed
+	}
+	
+	for _, board := range gameState.Boards {
+		response.Boards = append(response.Boards, newBoardResponse(board, gameState.IsFinished()))
+	}
+	
+	return response
+}
+
+// revealsDailyPuzzle reports whether viewerID may see the words of a game at now
+// Only daily games are restricted: to their owner until the puzzle's date has passed
+func revealsDailyPuzzle(gameState models.GameState, viewerID int64, now time.Time) bool {
+	if gameState.Mode != "daily" {
+		return true
+	}
+	return (viewerID != 0 && gameState.UserID == viewerID) || gameState.DailyDate < models.FormatDailyDate(now)
+}
+
+// hideLetters copies tries without their guessed words and letters, keeping the colours
+func hideLetters(tries []models.GuessResult) []models.GuessResult {
+	hidden := make([]models.GuessResult, 0, len(tries))
+	for _, try := range tries {
+		letterResults := make([]models.LetterResult, 0, len(try.LetterResultArray))
+		for _, letterResult := range try.LetterResultArray {
+			letterResults = append(letterResults, models.LetterResult{Status: letterResult.Status})
+		}
+		hidden = append(hidden, models.GuessResult{LetterResultArray: letterResults, IsCorrect: try.IsCorrect})
+	}
+	return hidden
+}
+
+// BoardResponse is the client-facing view of one board of a multi-board game
+type BoardResponse struct {
+	TargetWord string               `json:"targetWord,omitempty"` // Only set once solved or the game has ended
+	Tries      []models.GuessResult `json:"tries"`
+	Status     string               `json:"status"`
+}
+
+// newBoardResponse maps a board, revealing its target if it is solved or the game is over
+func newBoardResponse(board models.Board, gameFinished bool) BoardResponse {
+	response := BoardResponse{
+		Tries:  board.Tries,
+		Status: board.Status,
+	}
+	if response.Tries == nil {
+		response.Tries = []models.GuessResult{}
+	}
+	if gameFinished || board.Status == models.StatusWon {
+		response.TargetWord = board.TargetWord
+	}
+	return response
+}
+
+// newGameStateResponses maps a list of game states for collection endpoints
+func newGameStateResponses(gameStates []models.GameState, viewerID int64) []GameStateResponse {
+	responses := make([]GameStateResponse, 0, len(gameStates))
+	for _, gameState := range gameStates {
+		responses = append(responses, newGameStateResponse(gameState, "", viewerID))
+	}
+	return responses
+}
+
+// RaceResponse is the client-facing view of a race, for its players and for later review
+type RaceResponse struct {
+	Code       string               `json:"code"`
+	HostID     int64                `json:"hostId"`
+	TargetWord string               `json:"targetWord,omitempty"` // Only set once the race is finished
+	Language   string               `json:"language"`
+	WordSize   int                  `json:"wordSize"`
+	MaxTries   int                  `json:"maxTries"`
+	MaxPlayers int                  `json:"maxPlayers"`
+	Status     string               `json:"status"`
+	WinnerID   int64                `json:"winnerId,omitempty"`
+	Players    []RacePlayerResponse `json:"players"`
+	Guesses    []models.RaceGuess   `json:"guesses"` // Opponents' letters only once the race is finished
+	CreatedAt  time.Time            `json:"createdAt"`
+	StartedAt  *time.Time           `json:"startedAt,omitempty"`
+	FinishedAt *time.Time           `json:"finishedAt,omitempty"`
+}
+
+// RacePlayerResponse is the client-facing view of one race player
+type RacePlayerResponse struct {
+	UserID     int64      `json:"userId"`
+	Username   string     `json:"username"`
+	Status     string     `json:"status"`
+	Guesses    int        `json:"guesses"`
+	JoinedAt   time.Time  `json:"joinedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// newRaceResponse maps a race and its guesses as seen by viewerID
+// Until the race is finished a viewer only sees the letters of their own guesses
+func newRaceResponse(race models.Race, guesses []models.RaceGuess, viewerID int64) RaceResponse {
+	finished := race.Status == models.RaceFinished
+	response := RaceResponse{
+		Code:       race.Code,
+		HostID:     race.HostID,
+		Language:   race.Language,
+		WordSize:   race.WordSize,
+		MaxTries:   race.MaxTries,
+		MaxPlayers: race.MaxPlayers,
+		Status:     race.Status,
+		WinnerID:   race.WinnerID,
+		Players:    make([]RacePlayerResponse, 0, len(race.Players)),
+		Guesses:    make([]models.RaceGuess, 0, len(guesses)),
+		CreatedAt:  race.CreatedAt,
+	}
+	if finished {
+		response.TargetWord = race.TargetWord
+	}
+	if !race.StartedAt.IsZero() {
+		response.StartedAt = &race.StartedAt
+	}
+	if !race.FinishedAt.IsZero() {
+		response.FinishedAt = &race.FinishedAt
+	}
+	
+	for _, player := range race.Players {
+		playerResponse := RacePlayerResponse{
+			UserID:   player.UserID,
+			Username: player.Username,
+			Status:   player.Status,
+			Guesses:  player.Guesses,
+			JoinedAt: player.JoinedAt,
+		}
+		if !player.FinishedAt.IsZero() {
+			playerResponse.FinishedAt = &player.FinishedAt
+		}
+		response.Players = append(response.Players, playerResponse)
+	}
+	
+	for _, guess := range guesses {
+		if !finished && guess.UserID != viewerID {
+			guess.GuessWord = ""
+		}
+		response.Guesses = append(response.Guesses, guess)
+	}
+	
+	return response
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"testing"
+	"time"
+	"wordle-backend/models"
+)
+
+func TestDailyWordOnlyRevealedToOwnerUntilTheDayIsOver(t *testing.T) {
+	now := time.Now()
+	gameState := models.GameState{
+		TargetWord: "CRANE",
+		Tries:      []models.GuessResult{{GuessWord: "CRANE", LetterResultArray: models.ValidateGuess("CRANE", "CRANE"), IsCorrect: true}},
+		GameStatus: models.StatusWon,
+		Mode:       "daily",
+		UserID:     7,
+		DailyDate:  models.FormatDailyDate(now),
+	}
+
+	tests := []struct {
+		name     string
+		viewerID int64
+		date     string
+		revealed bool
+	}{
+		{"owner today", 7, gameState.DailyDate, true},
+		{"other player today", 8, gameState.DailyDate, false},
+		{"anonymous today", 0, gameState.DailyDate, false},
+		{"other player after the day", 8, models.FormatDailyDate(now.AddDate(0, 0, -1)), true},
+	}
+
+	for _, test := range tests {
+		gameState.DailyDate = test.date
+		response := newGameStateResponse(gameState, "", test.viewerID)
+		if revealed := response.TargetWord == "CRANE"; revealed != test.revealed {
+			t.Errorf("%s: target word %q, want revealed %t", test.name, response.TargetWord, test.revealed)
+		}
+		try := response.Tries[0]
+		if revealed := try.GuessWord == "CRANE" && try.LetterResultArray[0].Letter == "C"; revealed != test.revealed {
+			t.Errorf("%s: try %+v, want letters revealed %t", test.name, try, test.revealed)
+		}
+		if try.LetterResultArray[0].Status != "correct" || !try.IsCorrect {
+			t.Errorf("%s: try %+v lost its colours", test.name, try)
+		}
+	}
+}
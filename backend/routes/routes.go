@@ -1,10 +1,14 @@
 package routes
 
 import (
+	"wordle-backend/middlewares"
+
 	"github.com/gin-gonic/gin"
 )
 
 func RegisterRoutes(server *gin.Engine) {
+	server.Use(middlewares.Authenticate())
+	
 	server.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok", "message": "Server is running"})
 	})
@@ -15,4 +19,32 @@ func RegisterRoutes(server *gin.Engine) {
 	server.PUT("/gamestates", playGameState)
 	server.PUT("/gamestates/:id", leaveGameStateByID)
 	server.PUT("/gamestates/:id/timeout", timeoutGameStateByID)
+	
+	server.GET("/daily/:date", getDailyPuzzle)
+	
+	server.POST("/challenges", middlewares.RequireAuth(), createChallenge)
+	server.GET("/challenges/:code", getChallenge)
+	server.GET("/challenges/:code/results", middlewares.RequireAuth(), getChallengeResults)
+	
+	server.POST("/races", middlewares.RequireAuth(), createRace)
+	server.GET("/races/:code", getRace)
+	server.POST("/races/:code/join", middlewares.RequireAuth(), joinRace)
+	server.POST("/races/:code/start", middlewares.RequireAuth(), startRace)
+	server.GET("/races/:code/ws", raceSocket)
+	
+	server.POST("/auth/register", register)
+	server.POST("/auth/login", login)
+	server.POST("/auth/logout", middlewares.RequireAuth(), logout)
+	server.GET("/auth/me", middlewares.RequireAuth(), getCurrentUser)
+	server.POST("/auth/claim", middlewares.RequireAuth(), claimGuestGames)
+	
+	server.GET("/players/:id/stats", getPlayerStats)
+	server.GET("/leaderboards", getLeaderboard)
+	
+	admin := server.Group("/admin", middlewares.RequireAuth(), middlewares.RequireAdmin())
+	admin.GET("/wordlists/:wordSize", getWordList)
+	admin.POST("/wordlists/:wordSize/words", addWords)
+	admin.POST("/wordlists/:wordSize/import", importWords)
+	admin.DELETE("/wordlists/:wordSize/words/:word", removeWord)
+	admin.GET("/wordlist-changes", getWordListChanges)
 }
@@ -150,7 +150,7 @@ export const GameProvider: React.FC<GameProviderProps> = ({ children }) => {
     setError(null);
     
     try {
-      const response: CreateGameStateResponse = await apiService.createGameState(maxTries, wordSize);
+      const response: CreateGameStateResponse = await apiService.createGameState(maxTries, wordSize, timeLimit);
       
       const newGameState: GameState = {
         id: response.id,
@@ -160,7 +160,7 @@ export const GameProvider: React.FC<GameProviderProps> = ({ children }) => {
         maxTries: response.maxTries,
         wordSize: response.wordSize || wordSize,
         mode: 'speed',
-        timeLimit: timeLimit,
+        timeLimit: response.timeLimit || timeLimit,
         createdAt: response.createdAt,
         updatedAt: response.updatedAt,
       };
@@ -13,10 +13,14 @@
 //
 // PERFORMANCE CONSIDERATIONS:
 // - TypeScript interfaces for compile-time type safety
-import { CreateGameStateResponse, GameState, PlayGameStateRequest, PlayGameStateResponse, GameLostResponse, PlayGameStateErrorType } from '../types/core';
+import { CreateGameStateResponse, GameState, GameStatePage, PlayGameStateRequest, PlayGameStateResponse, GameLostResponse, PlayGameStateErrorType } from '../types/core';
 
 const API_BASE_URL = process.env.REACT_APP_API_URL || 'http://localhost:8080';
 
+// Bearer token for server-side games; the backend issues a guest token on the
+// first game and it is reused until the player claims their games into an account
+const AUTH_TOKEN_STORAGE_KEY = 'wordleAuthToken';
+
 // Custom error class for invalid word submissions
 // DESIGN DECISION: Specific error type for better error handling in UI
 // Contains the invalid word for user feedback
@@ -39,14 +43,25 @@ class ApiService {
   ): Promise<T> {
     const url = `${API_BASE_URL}${endpoint}`;
     
+    const headers: Record<string, string> = {
+      'Content-Type': 'application/json',
+    };
+    const authToken = localStorage.getItem(AUTH_TOKEN_STORAGE_KEY);
+    if (authToken) {
+      headers['Authorization'] = `Bearer ${authToken}`;
+    }
+
     const defaultOptions: RequestInit = {
-      headers: {
-        'Content-Type': 'application/json',
-      },
+      headers,
     };
 
     const response = await fetch(url, { ...defaultOptions, ...options });
 
+    if (response.status === 401 && authToken) {
+      // Stale token (e.g. expired or revoked); the next game will issue a new guest token
+      localStorage.removeItem(AUTH_TOKEN_STORAGE_KEY);
+    }
+
     if (!response.ok) {
       const errorData: PlayGameStateErrorType = await response.json();
       if (errorData.invalid_guess_word) {
@@ -61,16 +76,21 @@ class ApiService {
     return response.json();
   }
 
-  async createGameState(maxTries?: number, wordSize?: number): Promise<CreateGameStateResponse> {
-    const body = maxTries && wordSize ? { maxTries, wordSize } : {};
-    return this.makeRequest<CreateGameStateResponse>('/gamestates', {
+  async createGameState(maxTries?: number, wordSize?: number, timeLimit?: number): Promise<CreateGameStateResponse> {
+    const body = maxTries && wordSize ? { maxTries, wordSize, timeLimit } : {};
+    const response = await this.makeRequest<CreateGameStateResponse>('/gamestates', {
       method: 'POST',
       body: JSON.stringify(body),
     });
+    if (response.guestToken) {
+      localStorage.setItem(AUTH_TOKEN_STORAGE_KEY, response.guestToken);
+    }
+    return response;
   }
 
-  async getAllGameStates(): Promise<GameState[]> {
-    return this.makeRequest<GameState[]>('/gamestates', {
+  async getAllGameStates(cursor?: string): Promise<GameStatePage> {
+    const query = cursor ? `?cursor=${encodeURIComponent(cursor)}` : '';
+    return this.makeRequest<GameStatePage>(`/gamestates${query}`, {
       method: 'GET',
     });
   }
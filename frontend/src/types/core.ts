@@ -13,7 +13,7 @@ export interface GameState {
   id?: number;
   currentGuessWord?: string;
   tries: GuessResult[];
-  gameStatus: 'playing' | 'won' | 'lost' | 'timeout';
+  gameStatus: 'playing' | 'won' | 'lost' | 'timeout' | 'abandoned';
   targetWord?: string;
   maxTries: number;
   wordSize?: number;
@@ -23,16 +23,24 @@ export interface GameState {
   updatedAt?: string;
 }
 
+export interface GameStatePage {
+  items: GameState[];
+  nextCursor: string | null;
+}
+
 export interface CreateGameStateResponse {
   message: string;
   id: number;
   tries: GuessResult[];
-  gameStatus: 'playing' | 'won' | 'lost' | 'timeout';
+  gameStatus: 'playing' | 'won' | 'lost' | 'timeout' | 'abandoned';
   mode: string;
   maxTries: number;
   wordSize: number;
+  timeLimit: number;
+  guessDeadline: string;
   updatedAt: string;
   createdAt: string;
+  guestToken?: string;
 }
 
 export interface PlayGameStateRequest {
@@ -44,7 +52,7 @@ export interface PlayGameStateResponse {
   message: string;
   id: number;
   tries: GuessResult[];
-  gameStatus: 'playing' | 'won' | 'lost' | 'timeout';
+  gameStatus: 'playing' | 'won' | 'lost' | 'timeout' | 'abandoned';
   mode: string;
   maxTries: number;
   wordSize: number;
@@ -58,7 +66,7 @@ export interface GameLostResponse {
   id: number;
   targetWord: string;
   tries: GuessResult[];
-  gameStatus: 'lost' | 'timeout';
+  gameStatus: 'lost' | 'timeout' | 'abandoned';
   mode: string;
   maxTries: number;
   wordSize: number;